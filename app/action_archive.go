@@ -0,0 +1,125 @@
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/archive"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/protocol"
+	"github.com/zenon-network/go-zenon/verifier"
+	"github.com/zenon-network/go-zenon/vm"
+)
+
+var (
+	exportFromFlag = &cli.Uint64Flag{
+		Name:  "from",
+		Usage: "Height of the first exported momentum",
+		Value: 2,
+	}
+	exportToFlag = &cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Height of the last exported momentum, defaults to the frontier momentum",
+	}
+
+	exportCommand = &cli.Command{
+		Action:    exportAction,
+		Name:      "export",
+		Usage:     "Write the momentums of the local chain, with their account-blocks, to an archive file",
+		ArgsUsage: "<archive-file>",
+		Category:  "ARCHIVE COMMANDS",
+		Flags: []cli.Flag{
+			exportFromFlag,
+			exportToFlag,
+		},
+	}
+	importCommand = &cli.Command{
+		Action:    importAction,
+		Name:      "import",
+		Usage:     "Verify and insert the momentums of an archive file. An interrupted import resumes from the local frontier",
+		ArgsUsage: "<archive-file>",
+		Category:  "ARCHIVE COMMANDS",
+	}
+)
+
+func exportAction(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.Errorf("expected the archive file as the only argument")
+	}
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	ch, err := nodeConfig.OpenChain()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ch.Stop(); err != nil {
+			log.Error("failed to stop chain", "reason", err)
+		}
+	}()
+
+	from := ctx.Uint64(exportFromFlag.Name)
+	to := ctx.Uint64(exportToFlag.Name)
+	if !ctx.IsSet(exportToFlag.Name) {
+		to = ch.GetFrontierMomentumStore().Identifier().Height
+	}
+
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	fmt.Printf("exporting momentums %v to %v\n", from, to)
+	if err := archive.Export(ch, from, to, file); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	fmt.Printf("exported %v momentums to %v\n", to-from+1, ctx.Args().First())
+	return nil
+}
+
+func importAction(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.Errorf("expected the archive file as the only argument")
+	}
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return withConsensusDB(ctx, func(consensusDB db.DB, ch chain.Chain) error {
+		cs := consensus.NewConsensus(consensusDB, ch, true)
+		if err := cs.Init(); err != nil {
+			return err
+		}
+		if err := cs.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			if err := cs.Stop(); err != nil {
+				log.Error("failed to stop consensus", "reason", err)
+			}
+		}()
+		bridge := protocol.NewChainBridge(ch, cs, verifier.NewVerifier(ch, cs), vm.NewSupervisor(ch, cs))
+
+		fmt.Printf("importing %v into the chain at momentum %v\n", ctx.Args().First(), ch.GetFrontierMomentumStore().Identifier())
+		progress, err := archive.Import(ch, file, bridge.InsertChain, func(progress *archive.Progress) {
+			fmt.Printf("imported momentum %v of %v\n", progress.Height, progress.ToHeight)
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("imported %v momentums, skipped %v momentums already in the chain\n", progress.Inserted, progress.Skipped)
+		return nil
+	})
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus"
+)
+
+var (
+	consensusCommand = &cli.Command{
+		Name:     "consensus",
+		Usage:    "Maintain the consensus DB of a stopped node",
+		Category: "CONSENSUS COMMANDS",
+		Subcommands: []*cli.Command{
+			{
+				Action:    consensusRebuildAction,
+				Name:      "rebuild",
+				Usage:     "Recompute the election results and the period and epoch points from the momentums",
+				ArgsUsage: " ",
+			},
+			{
+				Action:    consensusVerifyAction,
+				Name:      "verify",
+				Usage:     "Compare the stored election results and points with the recomputed ones and report the first divergence",
+				ArgsUsage: " ",
+			},
+		},
+	}
+)
+
+// withConsensusDB opens the local chain and consensus DB for the duration of f
+func withConsensusDB(ctx *cli.Context, f func(consensusDB db.DB, ch chain.Chain) error) error {
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	ch, err := nodeConfig.OpenChain()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ch.Stop(); err != nil {
+			log.Error("failed to stop chain", "reason", err)
+		}
+	}()
+	consensusDB, ldb, err := nodeConfig.OpenConsensusDB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ldb.Close(); err != nil {
+			log.Error("failed to close consensus DB", "reason", err)
+		}
+	}()
+	return f(consensusDB, ch)
+}
+
+func consensusRebuildAction(ctx *cli.Context) error {
+	return withConsensusDB(ctx, func(consensusDB db.DB, ch chain.Chain) error {
+		fmt.Printf("rebuilding the consensus DB up to momentum %v\n", ch.GetFrontierMomentumStore().Identifier())
+		result, err := consensus.RebuildDB(consensusDB, ch)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("rebuilt %v elections, %v period points and %v epoch points\n", result.Elections, result.PeriodPoints, result.EpochPoints)
+		return nil
+	})
+}
+
+func consensusVerifyAction(ctx *cli.Context) error {
+	return withConsensusDB(ctx, func(consensusDB db.DB, ch chain.Chain) error {
+		// the recomputed entries are written to a temporary DB, since they don't fit in memory on long chains
+		scratchDir, err := os.MkdirTemp("", "consensus-verify")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(scratchDir)
+		scratch, err := leveldb.OpenFile(scratchDir, nil)
+		if err != nil {
+			return err
+		}
+		defer scratch.Close()
+
+		fmt.Printf("verifying the consensus DB up to momentum %v\n", ch.GetFrontierMomentumStore().Identifier())
+		divergence, err := consensus.VerifyDB(consensusDB, db.NewLevelDBWrapper(scratch), ch)
+		if err != nil {
+			return err
+		}
+		if divergence == nil {
+			fmt.Println("the consensus DB matches the chain")
+			return nil
+		}
+		fmt.Printf("first divergence: %v at tick %v\n", divergence.Kind, divergence.Tick)
+		if divergence.Stored == "" {
+			fmt.Println("stored:   missing")
+		} else {
+			fmt.Printf("stored:   %v\n", divergence.Stored)
+		}
+		fmt.Printf("computed: %v\n", divergence.Computed)
+		return errors.Errorf("the consensus DB diverges from the chain, run the consensus rebuild command to fix it")
+	})
+}
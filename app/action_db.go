@@ -0,0 +1,96 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/verifier"
+	"github.com/zenon-network/go-zenon/zenon"
+)
+
+var (
+	dbVerifyFromFlag = &cli.Uint64Flag{
+		Name:  "from",
+		Usage: "Height of the first verified momentum, defaults to the genesis",
+		Value: 1,
+	}
+
+	dbCommand = &cli.Command{
+		Name:     "db",
+		Usage:    "Maintain the chain DB of a stopped node",
+		Category: "CHAIN COMMANDS",
+		Subcommands: []*cli.Command{
+			{
+				Action:    dbVerifyAction,
+				Name:      "verify",
+				Usage:     "Replay the stored patches, hashes and signatures of the momentums and report the first corrupted height",
+				ArgsUsage: " ",
+				Flags: []cli.Flag{
+					dbVerifyFromFlag,
+				},
+			},
+			{
+				Action:    dbMigrateAction,
+				Name:      "migrate",
+				Usage:     "Copy the leveldb chain DB into a new pebble DB, set DBBackend to pebble to use it",
+				ArgsUsage: " ",
+			},
+		},
+	}
+)
+
+func dbVerifyAction(ctx *cli.Context) error {
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	ch, err := nodeConfig.OpenChain()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ch.Stop(); err != nil {
+			log.Error("failed to stop chain", "reason", err)
+		}
+	}()
+
+	fromHeight := ctx.Uint64(dbVerifyFromFlag.Name)
+	fmt.Printf("verifying the chain DB from height %v up to momentum %v\n", fromHeight, ch.GetFrontierMomentumStore().Identifier())
+	corruption, err := verifier.VerifyIntegrity(ch, fromHeight, func(height, frontierHeight uint64) {
+		fmt.Printf("verified %v/%v momentums\n", height, frontierHeight)
+	})
+	if err != nil {
+		return err
+	}
+	if corruption == nil {
+		fmt.Println("the chain DB is consistent")
+		return nil
+	}
+	fmt.Printf("first corrupted height: %v\n", corruption.Height)
+	fmt.Printf("reason: %v\n", corruption.Reason)
+	return errors.Errorf("the chain DB is corrupted at height %v, roll back below it or resync", corruption.Height)
+}
+
+func dbMigrateAction(ctx *cli.Context) error {
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	from := &zenon.Config{DataDir: nodeConfig.DataPath, DBBackend: db.LevelDBBackend}
+	to := &zenon.Config{DataDir: nodeConfig.DataPath, DBBackend: db.PebbleBackend}
+
+	fmt.Printf("copying %v into %v\n", from.DBPath("nom"), to.DBPath("nom"))
+	keys, err := db.MigrateLevelDBToPebble(from.DBPath("nom"), to.DBPath("nom"), func(keys uint64) {
+		if keys%1000000 == 0 {
+			fmt.Printf("copied %v keys\n", keys)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("copied %v keys, set DBBackend to %v in the config to use the new DB\n", keys, db.PebbleBackend)
+	return nil
+}
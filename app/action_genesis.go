@@ -0,0 +1,371 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/chain/genesis"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+var (
+	genesisOutputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "File to write the genesis to, defaults to stdout",
+	}
+	genesisChainIdentifierFlag = &cli.Uint64Flag{
+		Name:  "chain-identifier",
+		Usage: "Chain identifier of the new network",
+		Value: 1,
+	}
+	genesisSporkAddressFlag = &cli.StringFlag{
+		Name:     "spork-address",
+		Usage:    "Address allowed to create and activate sporks",
+		Required: true,
+	}
+	genesisPillarFlag = &cli.StringSliceFlag{
+		Name:  "pillar",
+		Usage: "Address of a genesis pillar, can be repeated",
+	}
+	genesisTimestampFlag = &cli.Int64Flag{
+		Name:  "timestamp",
+		Usage: "Genesis timestamp in seconds, defaults to now",
+	}
+	genesisHeightFlag = &cli.Uint64Flag{
+		Name:  "height",
+		Usage: "Height of the exported momentum, defaults to the frontier momentum",
+	}
+	genesisExportSporkAddressFlag = &cli.StringFlag{
+		Name:  "spork-address",
+		Usage: "Address allowed to create and activate sporks, defaults to the spork address of the exported chain",
+	}
+	genesisMinBalanceFlag = &cli.StringFlag{
+		Name:  "min-balance",
+		Usage: "Drop the balances lower than this amount, in base units",
+	}
+	genesisTokenFlag = &cli.StringSliceFlag{
+		Name:  "token",
+		Usage: "Token standard to export, can be repeated. All the tokens are exported if missing",
+	}
+
+	genesisCommand = &cli.Command{
+		Name:     "genesis",
+		Usage:    "Create and check genesis files",
+		Category: "GENESIS COMMANDS",
+		Subcommands: []*cli.Command{
+			{
+				Action:    genesisInitAction,
+				Name:      "init",
+				Usage:     "Write a valid genesis template",
+				ArgsUsage: " ",
+				Flags: []cli.Flag{
+					genesisOutputFlag,
+					genesisChainIdentifierFlag,
+					genesisSporkAddressFlag,
+					genesisPillarFlag,
+					genesisTimestampFlag,
+				},
+			},
+			{
+				Action:    genesisExportAction,
+				Name:      "export",
+				Usage:     "Write a genesis seeded with the state of the local chain at a momentum height",
+				ArgsUsage: " ",
+				Flags: []cli.Flag{
+					genesisOutputFlag,
+					genesisChainIdentifierFlag,
+					genesisExportSporkAddressFlag,
+					genesisTimestampFlag,
+					genesisHeightFlag,
+					genesisMinBalanceFlag,
+					genesisTokenFlag,
+				},
+			},
+			{
+				Action:    genesisValidateAction,
+				Name:      "validate",
+				Usage:     "Report every error of a genesis file",
+				ArgsUsage: "<genesis-file>",
+			},
+			{
+				Action:    genesisHashAction,
+				Name:      "hash",
+				Usage:     "Print the genesis momentum hash and the chain identifier",
+				ArgsUsage: "<genesis-file>",
+			},
+			{
+				Action:    genesisInspectAction,
+				Name:      "inspect",
+				Usage:     "Print the balances, pillars, fusions and tokens of a genesis file",
+				ArgsUsage: "<genesis-file>",
+			},
+		},
+	}
+)
+
+func genesisInitAction(ctx *cli.Context) error {
+	sporkAddress, err := types.ParseAddress(ctx.String(genesisSporkAddressFlag.Name))
+	if err != nil {
+		return errors.Wrapf(err, "invalid --%v", genesisSporkAddressFlag.Name)
+	}
+	pillars := make([]types.Address, 0)
+	for _, str := range ctx.StringSlice(genesisPillarFlag.Name) {
+		address, err := types.ParseAddress(str)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%v %v", genesisPillarFlag.Name, str)
+		}
+		pillars = append(pillars, address)
+	}
+	timestamp := time.Now().Unix()
+	if ctx.IsSet(genesisTimestampFlag.Name) {
+		timestamp = ctx.Int64(genesisTimestampFlag.Name)
+	}
+
+	config := genesis.MakeTemplate(&genesis.TemplateConfig{
+		ChainIdentifier:     ctx.Uint64(genesisChainIdentifierFlag.Name),
+		GenesisTimestampSec: timestamp,
+		SporkAddress:        sporkAddress,
+		Pillars:             pillars,
+	})
+	if err := genesis.CheckGenesis(config); err != nil {
+		return errors.Wrap(err, "generated an invalid genesis")
+	}
+
+	return writeGenesis(ctx, config)
+}
+
+func genesisExportAction(ctx *cli.Context) error {
+	exportConfig := &genesis.ExportConfig{
+		ChainIdentifier:     ctx.Uint64(genesisChainIdentifierFlag.Name),
+		GenesisTimestampSec: time.Now().Unix(),
+		Tokens:              make([]types.ZenonTokenStandard, 0),
+	}
+	if ctx.IsSet(genesisTimestampFlag.Name) {
+		exportConfig.GenesisTimestampSec = ctx.Int64(genesisTimestampFlag.Name)
+	}
+	if ctx.IsSet(genesisExportSporkAddressFlag.Name) {
+		sporkAddress, err := types.ParseAddress(ctx.String(genesisExportSporkAddressFlag.Name))
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%v", genesisExportSporkAddressFlag.Name)
+		}
+		exportConfig.SporkAddress = &sporkAddress
+	}
+	if ctx.IsSet(genesisMinBalanceFlag.Name) {
+		minBalance, ok := new(big.Int).SetString(ctx.String(genesisMinBalanceFlag.Name), 10)
+		if !ok || minBalance.Sign() < 0 {
+			return errors.Errorf("invalid --%v %v", genesisMinBalanceFlag.Name, ctx.String(genesisMinBalanceFlag.Name))
+		}
+		exportConfig.MinBalance = minBalance
+	}
+	for _, str := range ctx.StringSlice(genesisTokenFlag.Name) {
+		zts, err := types.ParseZTS(str)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%v %v", genesisTokenFlag.Name, str)
+		}
+		exportConfig.Tokens = append(exportConfig.Tokens, zts)
+	}
+
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	ch, err := nodeConfig.OpenChain()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ch.Stop(); err != nil {
+			log.Error("failed to stop chain", "reason", err)
+		}
+	}()
+
+	momentumStore := ch.GetFrontierMomentumStore()
+	if ctx.IsSet(genesisHeightFlag.Name) {
+		momentum, err := momentumStore.GetMomentumByHeight(ctx.Uint64(genesisHeightFlag.Name))
+		if err != nil {
+			return err
+		}
+		if momentum == nil {
+			return errors.Errorf("no momentum at height %v", ctx.Uint64(genesisHeightFlag.Name))
+		}
+		momentumStore, err = ch.GetMomentumStore(momentum.Identifier())
+		if err != nil {
+			return err
+		}
+		if momentumStore == nil {
+			return errors.Errorf("no state for momentum %v", momentum.Identifier())
+		}
+	}
+	fmt.Printf("exporting the state at momentum %v\n", momentumStore.Identifier())
+
+	config, err := genesis.ExportGenesis(momentumStore, exportConfig)
+	if err != nil {
+		return err
+	}
+	if errs := genesis.ValidateGenesis(config); len(errs) != 0 {
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+		return errors.Errorf("exported an invalid genesis: found %v errors", len(errs))
+	}
+	return writeGenesis(ctx, config)
+}
+
+// writeGenesis writes the genesis to the --output file, or to stdout
+func writeGenesis(ctx *cli.Context, config *genesis.GenesisConfig) error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	output := ctx.String(genesisOutputFlag.Name)
+	if output == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("genesis written to %v\n", output)
+	return nil
+}
+
+// readGenesisArg reads the genesis file given as the only argument, without validating it
+func readGenesisArg(ctx *cli.Context) (*genesis.GenesisConfig, error) {
+	if ctx.NArg() != 1 {
+		return nil, errors.Errorf("expected exactly one genesis file but got %v arguments", ctx.NArg())
+	}
+	return genesis.ReadGenesisConfig(ctx.Args().First())
+}
+
+// checkGenesisArg reads and validates the genesis file given as the only argument, printing every error found
+func checkGenesisArg(ctx *cli.Context) (*genesis.GenesisConfig, error) {
+	config, err := readGenesisArg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if errs := genesis.ValidateGenesis(config); len(errs) != 0 {
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+		return nil, errors.Errorf("invalid genesis: found %v errors", len(errs))
+	}
+	return config, nil
+}
+
+func genesisValidateAction(ctx *cli.Context) error {
+	config, err := checkGenesisArg(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("genesis is valid\n")
+	fmt.Printf("genesis-momentum hash: %v\n", genesis.NewGenesis(config).GetGenesisMomentum().Hash)
+	return nil
+}
+
+func genesisHashAction(ctx *cli.Context) error {
+	config, err := checkGenesisArg(ctx)
+	if err != nil {
+		return err
+	}
+	g := genesis.NewGenesis(config)
+	fmt.Printf("genesis-momentum hash: %v\n", g.GetGenesisMomentum().Hash)
+	fmt.Printf("chain identifier: %v\n", g.ChainIdentifier())
+	return nil
+}
+
+func genesisInspectAction(ctx *cli.Context) error {
+	config, err := readGenesisArg(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "chain identifier:\t%v\n", config.ChainIdentifier)
+	fmt.Fprintf(w, "timestamp:\t%v\n", config.GenesisTimestampSec)
+	if config.SporkAddress != nil {
+		fmt.Fprintf(w, "spork address:\t%v\n", config.SporkAddress)
+	}
+
+	if config.TokenConfig != nil {
+		fmt.Fprintf(w, "\nTOKENS\nstandard\tsymbol\tname\tdecimals\ttotal supply\tmax supply\towner\n")
+		for _, token := range config.TokenConfig.Tokens {
+			if token == nil {
+				continue
+			}
+			fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\n", token.TokenStandard, token.TokenSymbol, token.TokenName, token.Decimals, token.TotalSupply, token.MaxSupply, token.Owner)
+		}
+	}
+
+	if config.GenesisBlocks != nil {
+		balances := make(map[types.Address]map[types.ZenonTokenStandard]*big.Int)
+		for _, block := range config.GenesisBlocks.Blocks {
+			if block == nil {
+				continue
+			}
+			if _, ok := balances[block.Address]; !ok {
+				balances[block.Address] = make(map[types.ZenonTokenStandard]*big.Int)
+			}
+			for zts, amount := range block.BalanceList {
+				if amount == nil {
+					continue
+				}
+				if total, ok := balances[block.Address][zts]; ok {
+					total.Add(total, amount)
+				} else {
+					balances[block.Address][zts] = new(big.Int).Set(amount)
+				}
+			}
+		}
+		addresses := make([]types.Address, 0, len(balances))
+		for address := range balances {
+			addresses = append(addresses, address)
+		}
+		sort.Slice(addresses, func(i, j int) bool {
+			return addresses[i].String() < addresses[j].String()
+		})
+
+		fmt.Fprintf(w, "\nBALANCES\naddress\ttoken\tamount\n")
+		for _, address := range addresses {
+			tokens := make([]types.ZenonTokenStandard, 0, len(balances[address]))
+			for zts := range balances[address] {
+				tokens = append(tokens, zts)
+			}
+			sort.Slice(tokens, func(i, j int) bool {
+				return tokens[i].String() < tokens[j].String()
+			})
+			for _, zts := range tokens {
+				fmt.Fprintf(w, "%v\t%v\t%v\n", address, zts, balances[address][zts])
+			}
+		}
+	}
+
+	if config.PillarConfig != nil {
+		fmt.Fprintf(w, "\nPILLARS\nname\tproducing address\tstake address\treward address\tamount\n")
+		for _, pillar := range config.PillarConfig.Pillars {
+			if pillar == nil {
+				continue
+			}
+			fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", pillar.Name, pillar.BlockProducingAddress, pillar.StakeAddress, pillar.RewardWithdrawAddress, pillar.Amount)
+		}
+	}
+
+	if config.PlasmaConfig != nil {
+		fmt.Fprintf(w, "\nFUSIONS\nowner\tbeneficiary\tamount\texpiration height\n")
+		for _, fusion := range config.PlasmaConfig.Fusions {
+			if fusion == nil {
+				continue
+			}
+			fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", fusion.Owner, fusion.Beneficiary, fusion.Amount, fusion.ExpirationHeight)
+		}
+	}
+
+	return w.Flush()
+}
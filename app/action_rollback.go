@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+var (
+	rollbackHeightFlag = &cli.Uint64Flag{
+		Name:  "height",
+		Usage: "Height of the momentum which becomes the frontier",
+	}
+	rollbackHashFlag = &cli.StringFlag{
+		Name:  "hash",
+		Usage: "Hash of the momentum which becomes the frontier",
+	}
+	rollbackDryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Only print what the rollback removes",
+	}
+	rollbackFinalityDepthFlag = &cli.Uint64Flag{
+		Name:  "finality-depth",
+		Usage: "Maximum number of momentums removed, defaults to the FinalityDepth of the config",
+	}
+
+	rollbackCommand = &cli.Command{
+		Action:    rollbackAction,
+		Name:      "rollback",
+		Usage:     "Roll the local chain back to an older momentum, the node must be stopped",
+		ArgsUsage: " ",
+		Category:  "CHAIN COMMANDS",
+		Flags: []cli.Flag{
+			rollbackHeightFlag,
+			rollbackHashFlag,
+			rollbackDryRunFlag,
+			rollbackFinalityDepthFlag,
+		},
+	}
+)
+
+func rollbackAction(ctx *cli.Context) error {
+	if ctx.IsSet(rollbackHeightFlag.Name) == ctx.IsSet(rollbackHashFlag.Name) {
+		return errors.Errorf("expected exactly one of --%v and --%v", rollbackHeightFlag.Name, rollbackHashFlag.Name)
+	}
+	nodeConfig, err := MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	finalityDepth := nodeConfig.FinalityDepth
+	if ctx.IsSet(rollbackFinalityDepthFlag.Name) {
+		finalityDepth = ctx.Uint64(rollbackFinalityDepthFlag.Name)
+	}
+	ch, err := nodeConfig.OpenChain()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ch.Stop(); err != nil {
+			log.Error("failed to stop chain", "reason", err)
+		}
+	}()
+
+	momentumStore := ch.GetFrontierMomentumStore()
+	var target types.HashHeight
+	if ctx.IsSet(rollbackHeightFlag.Name) {
+		momentum, err := momentumStore.GetMomentumByHeight(ctx.Uint64(rollbackHeightFlag.Name))
+		if err != nil {
+			return err
+		}
+		if momentum == nil {
+			return errors.Errorf("there is no momentum at height %v", ctx.Uint64(rollbackHeightFlag.Name))
+		}
+		target = momentum.Identifier()
+	} else {
+		hash, err := types.HexToHash(ctx.String(rollbackHashFlag.Name))
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%v", rollbackHashFlag.Name)
+		}
+		momentum, err := momentumStore.GetMomentumByHash(hash)
+		if err != nil {
+			return err
+		}
+		if momentum == nil {
+			return errors.Errorf("there is no momentum with hash %v", hash)
+		}
+		target = momentum.Identifier()
+	}
+
+	summary, err := chain.PlanRollback(ch, target, finalityDepth)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("rollback from momentum %v to momentum %v\n", summary.From, summary.To)
+	fmt.Printf("removes %v momentums and %v account-blocks\n", summary.Momentums, summary.AccountBlocks)
+	if ctx.Bool(rollbackDryRunFlag.Name) {
+		return nil
+	}
+
+	insert := ch.AcquireInsert("rollback command")
+	defer insert.Unlock()
+	if err := ch.RollbackTo(insert, summary.To); err != nil {
+		return err
+	}
+	fmt.Printf("rolled back to momentum %v\n", ch.GetFrontierMomentumStore().Identifier())
+	fmt.Printf("run the consensus verify command to check the consensus DB\n")
+	return nil
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/protocol"
+	"github.com/zenon-network/go-zenon/verifier"
+	"github.com/zenon-network/go-zenon/vm"
+)
+
+var (
+	snapshotHeightFlag = &cli.Uint64Flag{
+		Name:  "height",
+		Usage: "Height of the snapshot momentum, defaults to the frontier momentum. The peers serve it once it's 100 momentums old",
+	}
+
+	snapshotCheckpointCommand = &cli.Command{
+		Action:    snapshotCheckpointAction,
+		Name:      "snapshot-checkpoint",
+		Usage:     "Compute the checkpoint of the snapshot of the local chain, for the snapshot-checkpoint flag of new nodes",
+		ArgsUsage: " ",
+		Category:  "SNAPSHOT COMMANDS",
+		Flags: []cli.Flag{
+			snapshotHeightFlag,
+		},
+	}
+)
+
+func snapshotCheckpointAction(ctx *cli.Context) error {
+	return withConsensusDB(ctx, func(consensusDB db.DB, ch chain.Chain) error {
+		cs := consensus.NewConsensus(consensusDB, ch, true)
+		if err := cs.Init(); err != nil {
+			return err
+		}
+		if err := cs.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			if err := cs.Stop(); err != nil {
+				log.Error("failed to stop consensus", "reason", err)
+			}
+		}()
+		bridge := protocol.NewChainBridge(ch, cs, verifier.NewVerifier(ch, cs), vm.NewSupervisor(ch, cs))
+
+		height := ctx.Uint64(snapshotHeightFlag.Name)
+		if !ctx.IsSet(snapshotHeightFlag.Name) {
+			height = ch.GetFrontierMomentumStore().Identifier().Height
+		}
+		fmt.Printf("computing the snapshot checkpoint of momentum %v\n", height)
+		checkpoint, err := protocol.ComputeSnapshotCheckpoint(bridge, height)
+		if err != nil {
+			return err
+		}
+		fmt.Println(checkpoint)
+		return nil
+	})
+}
@@ -54,6 +54,13 @@ func init() {
 	app.Commands = []*cli.Command{
 		versionCommand,
 		licenseCommand,
+		genesisCommand,
+		consensusCommand,
+		exportCommand,
+		importCommand,
+		rollbackCommand,
+		dbCommand,
+		snapshotCheckpointCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
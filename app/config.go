@@ -70,6 +70,10 @@ func applyFlagsToConfig(ctx *cli.Context, cfg *node.Config) {
 		cfg.Net.MaxPendingPeers = ctx.Int(MaxPendingPeersFlag.Name)
 	}
 
+	if ctx.IsSet(SnapshotCheckpointFlag.Name) {
+		cfg.Net.SnapshotCheckpoint = ctx.String(SnapshotCheckpointFlag.Name)
+	}
+
 	if listenHost := ctx.String(ListenHostFlag.Name); ctx.IsSet(ListenHostFlag.Name) && len(listenHost) > 0 {
 		cfg.RPC.HTTPHost = listenHost
 	}
@@ -104,6 +108,19 @@ func applyFlagsToConfig(ctx *cli.Context, cfg *node.Config) {
 		cfg.RPC.WSPort = ctx.Int(WSPortFlag.Name)
 	}
 
+	// Pruning Config
+	if ctx.IsSet(PruningFlag.Name) {
+		cfg.Pruning = ctx.Uint64(PruningFlag.Name)
+	}
+
+	// DB Config
+	if dbBackend := ctx.String(DBBackendFlag.Name); ctx.IsSet(DBBackendFlag.Name) && len(dbBackend) > 0 {
+		cfg.DBBackend = dbBackend
+	}
+	if ctx.IsSet(IndexerFlag.Name) {
+		cfg.Indexer = ctx.Bool(IndexerFlag.Name)
+	}
+
 	// Log Level Config
 	if logLevel := ctx.String(LogLvlFlag.Name); ctx.IsSet(LogLvlFlag.Name) && len(logLevel) > 0 {
 		cfg.LogLevel = logLevel
@@ -74,6 +74,10 @@ var (
 		Usage: "Maximum number of network peers (network disabled if set to 0)",
 		Value: p2p.DefaultMaxPeers,
 	}
+	SnapshotCheckpointFlag = &cli.StringFlag{
+		Name:  "snapshot-checkpoint",
+		Usage: "Sync from the snapshot of the trusted checkpoint height:hash:digest served by the peers instead of executing the history before it",
+	}
 	MaxPendingPeersFlag = &cli.UintFlag{
 		Name:  "max-pending-peers",
 		Usage: "Maximum number of db connection attempts (defaults used if set to 0)",
@@ -109,6 +113,24 @@ var (
 		Value: p2p.DefaultWSPort,
 	}
 
+	// pruning
+
+	PruningFlag = &cli.Uint64Flag{
+		Name:  "pruning",
+		Usage: "Keep only the last momentums and delete the older history, 0 keeps the whole history",
+	}
+
+	// db
+
+	DBBackendFlag = &cli.StringFlag{
+		Name:  "db-backend",
+		Usage: "Storage of the chain DB (leveldb,pebble)",
+	}
+	IndexerFlag = &cli.BoolFlag{
+		Name:  "indexer",
+		Usage: "Build the account-block search indexes and the balance history in the background",
+	}
+
 	// log
 
 	LogLvlFlag = &cli.StringFlag{
@@ -137,6 +159,7 @@ var (
 		ListenPortFlag,
 		MaxPeersFlag,
 		MaxPendingPeersFlag,
+		SnapshotCheckpointFlag,
 
 		// http rpc
 		RPCEnabledFlag,
@@ -148,6 +171,13 @@ var (
 		WSListenAddrFlag,
 		WSPortFlag,
 
+		// pruning
+		PruningFlag,
+
+		// db
+		DBBackendFlag,
+		IndexerFlag,
+
 		// log
 		LogLvlFlag,
 	}
@@ -27,6 +27,8 @@ var (
 
 type Stable interface {
 	GetStableAccountDB(address types.Address) db.DB
+	// CheckPruned returns db.ErrPruned if the account-block was confirmed by a momentum deleted by the pruning mode
+	CheckPruned(hash types.Hash) error
 }
 
 type accountPool struct {
@@ -168,17 +170,17 @@ func (ap *accountPool) GetPatch(address types.Address, identifier types.HashHeig
 
 	return ap.getAccountManager(address).GetPatch(identifier)
 }
-func (ap *accountPool) GetAccountStore(address types.Address, identifier types.HashHeight) store.Account {
+func (ap *accountPool) GetAccountStore(address types.Address, identifier types.HashHeight) (store.Account, error) {
 	ap.changes.Lock()
 	defer ap.changes.Unlock()
 
 	stable := ap.getStableAccountStore(address)
 	stableIdentifier := stable.Identifier()
 	if stableIdentifier == identifier {
-		return stable
+		return stable, nil
 	} else if stableIdentifier.Height > identifier.Height {
 		ap.log.Info("unable to get account store", "address", address, "stable-identifier", stableIdentifier, "reason", "older than most stable account")
-		return nil
+		return nil, ap.stable.CheckPruned(identifier.Hash)
 	}
 
 	manager := ap.getAccountManager(address)
@@ -186,10 +188,11 @@ func (ap *accountPool) GetAccountStore(address types.Address, identifier types.H
 	if accountDb == nil {
 		frontier := db.GetFrontierIdentifier(manager.Frontier())
 		ap.log.Info("unable to get account store", "address", address, "frontier-identifier", frontier, "reason", "missing-db")
-		return nil
+		return nil, nil
 	}
-	return account.NewAccountStore(address, accountDb)
+	return account.NewAccountStore(address, accountDb), nil
 }
+
 func (ap *accountPool) GetFrontierAccountStore(address types.Address) store.Account {
 	ap.changes.Lock()
 	defer ap.changes.Unlock()
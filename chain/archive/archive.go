@@ -0,0 +1,246 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// The archive file starts with a header:
+//   - the magic bytes followed by the format version
+//   - the chain identifier and the hash of the genesis momentum
+//   - the heights of the first and last exported momentums
+//
+// Followed by one record for each momentum, in order:
+//   - the length-prefixed protobuf of the momentum
+//   - the number of account-blocks, followed by the length-prefixed protobuf of each of them, in the order of the momentum content
+//
+// All the numbers and lengths are uvarints.
+const (
+	archiveVersion = uint64(1)
+	// maxRecordSize guards against allocating huge buffers when reading corrupted files
+	maxRecordSize = uint64(64 * 1024 * 1024)
+)
+
+var (
+	archiveMagic = []byte("znn-archive")
+
+	ErrInvalidArchive         = errors.New("invalid archive file")
+	ErrUnsupportedVersion     = errors.New("unsupported archive version")
+	ErrGenesisMismatch        = errors.New("the archive was exported from a different chain")
+	ErrArchiveDiverges        = errors.New("the archive diverges from the local chain")
+	ErrArchiveDoesNotLink     = errors.New("the archive doesn't link to the local chain")
+	ErrMissingAccountBlock    = errors.New("account-block is missing from the local chain, the node may be pruned")
+	ErrInvalidExportRange     = errors.New("invalid export range")
+	ErrMomentumOutsideArchive = errors.New("momentum outside the range of the archive")
+)
+
+// Header describes the momentums stored in an archive
+type Header struct {
+	Version         uint64     `json:"version"`
+	ChainIdentifier uint64     `json:"chainIdentifier"`
+	GenesisHash     types.Hash `json:"genesisHash"`
+	FromHeight      uint64     `json:"fromHeight"`
+	ToHeight        uint64     `json:"toHeight"`
+}
+
+// Writer writes an archive to an io.Writer. Flush must be called after the last momentum.
+type Writer struct {
+	w      *bufio.Writer
+	header Header
+	next   uint64
+	buf    []byte
+}
+
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	if header.FromHeight == 0 || header.FromHeight > header.ToHeight {
+		return nil, ErrInvalidExportRange
+	}
+	header.Version = archiveVersion
+	aw := &Writer{
+		w:      bufio.NewWriter(w),
+		header: header,
+		next:   header.FromHeight,
+		buf:    make([]byte, binary.MaxVarintLen64),
+	}
+	if _, err := aw.w.Write(archiveMagic); err != nil {
+		return nil, err
+	}
+	for _, value := range []uint64{header.Version, header.ChainIdentifier} {
+		if err := aw.writeUvarint(value); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := aw.w.Write(header.GenesisHash.Bytes()); err != nil {
+		return nil, err
+	}
+	for _, value := range []uint64{header.FromHeight, header.ToHeight} {
+		if err := aw.writeUvarint(value); err != nil {
+			return nil, err
+		}
+	}
+	return aw, nil
+}
+
+// Write appends the next momentum of the range with its account-blocks
+func (aw *Writer) Write(detailed *nom.DetailedMomentum) error {
+	if detailed.Momentum.Height != aw.next || aw.next > aw.header.ToHeight {
+		return errors.Wrapf(ErrMomentumOutsideArchive, "expected height %v but got %v", aw.next, detailed.Momentum.Height)
+	}
+	data, err := detailed.Momentum.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := aw.writeRecord(data); err != nil {
+		return err
+	}
+	if err := aw.writeUvarint(uint64(len(detailed.AccountBlocks))); err != nil {
+		return err
+	}
+	for _, block := range detailed.AccountBlocks {
+		data, err := block.Serialize()
+		if err != nil {
+			return err
+		}
+		if err := aw.writeRecord(data); err != nil {
+			return err
+		}
+	}
+	aw.next += 1
+	return nil
+}
+
+func (aw *Writer) Flush() error {
+	return aw.w.Flush()
+}
+
+func (aw *Writer) writeUvarint(value uint64) error {
+	n := binary.PutUvarint(aw.buf, value)
+	_, err := aw.w.Write(aw.buf[:n])
+	return err
+}
+func (aw *Writer) writeRecord(data []byte) error {
+	if err := aw.writeUvarint(uint64(len(data))); err != nil {
+		return err
+	}
+	_, err := aw.w.Write(data)
+	return err
+}
+
+// Reader reads the momentums of an archive in order
+type Reader struct {
+	r      *bufio.Reader
+	header Header
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{
+		r: bufio.NewReader(r),
+	}
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(ar.r, magic); err != nil || !bytes.Equal(magic, archiveMagic) {
+		return nil, ErrInvalidArchive
+	}
+	var err error
+	if ar.header.Version, err = ar.readUvarint(); err != nil {
+		return nil, err
+	}
+	if ar.header.Version != archiveVersion {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "version %v", ar.header.Version)
+	}
+	if ar.header.ChainIdentifier, err = ar.readUvarint(); err != nil {
+		return nil, err
+	}
+	hash := make([]byte, types.HashSize)
+	if _, err := io.ReadFull(ar.r, hash); err != nil {
+		return nil, ErrInvalidArchive
+	}
+	if ar.header.GenesisHash, err = types.BytesToHash(hash); err != nil {
+		return nil, err
+	}
+	if ar.header.FromHeight, err = ar.readUvarint(); err != nil {
+		return nil, err
+	}
+	if ar.header.ToHeight, err = ar.readUvarint(); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+func (ar *Reader) Header() Header {
+	return ar.header
+}
+
+// Next returns the next momentum with its account-blocks or io.EOF after the last one
+func (ar *Reader) Next() (*nom.DetailedMomentum, error) {
+	data, err := ar.readRecord()
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, err
+	}
+	momentum, err := nom.DeserializeMomentum(data)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+	count, err := ar.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if count != uint64(len(momentum.Content)) {
+		return nil, errors.Wrapf(ErrInvalidArchive, "momentum %v has %v account-blocks but the archive has %v", momentum.Identifier(), len(momentum.Content), count)
+	}
+	blocks := make([]*nom.AccountBlock, count)
+	for i := range blocks {
+		data, err := ar.readRecord()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if blocks[i], err = nom.DeserializeAccountBlock(data); err != nil {
+			return nil, errors.Wrap(ErrInvalidArchive, err.Error())
+		}
+	}
+	return &nom.DetailedMomentum{
+		Momentum:      momentum,
+		AccountBlocks: blocks,
+	}, nil
+}
+
+func (ar *Reader) readUvarint() (uint64, error) {
+	value, err := binary.ReadUvarint(ar.r)
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return value, nil
+}
+
+// readRecord returns io.EOF only if the archive ends before the record
+func (ar *Reader) readRecord() ([]byte, error) {
+	length, err := binary.ReadUvarint(ar.r)
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if length > maxRecordSize {
+		return nil, errors.Wrapf(ErrInvalidArchive, "record of %v bytes", length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(ar.r, data); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return data, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.Wrap(ErrInvalidArchive, "unexpected end of file")
+	}
+	return err
+}
@@ -0,0 +1,164 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+)
+
+const (
+	// importBatchSize is the number of momentums passed to each InsertFn call
+	importBatchSize = 100
+)
+
+// InsertFn verifies and inserts consecutive momentums, like protocol.ChainBridge.InsertChain
+type InsertFn func([]*nom.DetailedMomentum) (int, error)
+
+// ProgressFn is called after each inserted batch
+type ProgressFn func(progress *Progress)
+
+// Progress of an import. Skipped counts the momentums which were already in the local chain.
+type Progress struct {
+	Height   uint64 `json:"height"`
+	ToHeight uint64 `json:"toHeight"`
+	Inserted uint64 `json:"inserted"`
+	Skipped  uint64 `json:"skipped"`
+}
+
+// Export writes the momentums between fromHeight and toHeight, inclusive, with their account-blocks
+func Export(ch chain.Chain, fromHeight, toHeight uint64, w io.Writer) error {
+	momentumStore := ch.GetFrontierMomentumStore()
+	frontier, err := momentumStore.GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+	if toHeight > frontier.Height {
+		return errors.Wrapf(ErrInvalidExportRange, "height %v is above the frontier momentum %v", toHeight, frontier.Height)
+	}
+	genesis := ch.GetGenesisMomentum()
+	aw, err := NewWriter(w, Header{
+		ChainIdentifier: genesis.ChainIdentifier,
+		GenesisHash:     genesis.Hash,
+		FromHeight:      fromHeight,
+		ToHeight:        toHeight,
+	})
+	if err != nil {
+		return err
+	}
+
+	for height := fromHeight; height <= toHeight; height += 1 {
+		momentum, err := momentumStore.GetMomentumByHeight(height)
+		if err != nil {
+			return err
+		}
+		blocks := make([]*nom.AccountBlock, len(momentum.Content))
+		for i, header := range momentum.Content {
+			block, err := momentumStore.GetAccountBlock(*header)
+			if err != nil {
+				return err
+			}
+			if block == nil {
+				return errors.Wrapf(ErrMissingAccountBlock, "account-block %v of momentum %v", header, momentum.Identifier())
+			}
+			blocks[i] = block
+		}
+		if err := aw.Write(&nom.DetailedMomentum{
+			Momentum:      momentum,
+			AccountBlocks: blocks,
+		}); err != nil {
+			return err
+		}
+	}
+	return aw.Flush()
+}
+
+// Import inserts the momentums of the archive which follow the frontier of the local chain.
+//
+// The momentums already in the local chain are skipped, so an interrupted import can be resumed with the same archive.
+// Fails if the archive diverges from the local chain or starts after its frontier.
+// The complete momentums of a truncated archive are inserted before reporting ErrInvalidArchive.
+func Import(ch chain.Chain, r io.Reader, insert InsertFn, progress ProgressFn) (*Progress, error) {
+	log := common.ChainLogger.New("submodule", "archive-import")
+	ar, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	header := ar.Header()
+	genesis := ch.GetGenesisMomentum()
+	if header.GenesisHash != genesis.Hash || header.ChainIdentifier != genesis.ChainIdentifier {
+		return nil, errors.Wrapf(ErrGenesisMismatch, "archive genesis %v local genesis %v", header.GenesisHash, genesis.Hash)
+	}
+
+	current := &Progress{
+		ToHeight: header.ToHeight,
+	}
+	batch := make([]*nom.DetailedMomentum, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if index, err := insert(batch); err != nil {
+			return errors.Wrapf(err, "failed to insert momentum %v", batch[index].Momentum.Identifier())
+		}
+		current.Height = batch[len(batch)-1].Momentum.Height
+		current.Inserted += uint64(len(batch))
+		batch = batch[:0]
+		log.Info("imported momentums", "height", current.Height, "to-height", current.ToHeight)
+		if progress != nil {
+			progress(current)
+		}
+		return nil
+	}
+
+	for {
+		detailed, err := ar.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			// keep the complete momentums of truncated archives
+			if flushErr := flush(); flushErr != nil {
+				return nil, flushErr
+			}
+			return current, err
+		}
+		momentum := detailed.Momentum
+
+		if len(batch) == 0 {
+			momentumStore := ch.GetFrontierMomentumStore()
+			frontier, err := momentumStore.GetFrontierMomentum()
+			if err != nil {
+				return nil, err
+			}
+			if momentum.Height <= frontier.Height {
+				our, err := momentumStore.GetMomentumByHeight(momentum.Height)
+				if err != nil {
+					return nil, err
+				}
+				if our.Hash != momentum.Hash {
+					return nil, errors.Wrapf(ErrArchiveDiverges, "archive has %v local chain has %v", momentum.Identifier(), our.Identifier())
+				}
+				current.Height = momentum.Height
+				current.Skipped += 1
+				continue
+			}
+			if momentum.Previous() != frontier.Identifier() {
+				return nil, errors.Wrapf(ErrArchiveDoesNotLink, "momentum %v doesn't follow the frontier momentum %v", momentum.Identifier(), frontier.Identifier())
+			}
+		}
+
+		batch = append(batch, detailed)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/db"
-	"github.com/zenon-network/go-zenon/common/types"
 )
 
 var (
@@ -30,7 +29,13 @@ type chain struct {
 }
 
 func NewChain(chainManager db.Manager, genesis store.Genesis) *chain {
-	momentumPool := NewMomentumPool(chainManager, genesis)
+	return NewPrunedChain(chainManager, genesis, 0)
+}
+
+// NewPrunedChain returns a chain which keeps only the last pruning momentums and the account-blocks they confirmed.
+// The whole history is kept if pruning is 0.
+func NewPrunedChain(chainManager db.Manager, genesis store.Genesis, pruning uint64) *chain {
+	momentumPool := NewMomentumPool(chainManager, genesis, pruning)
 	return &chain{
 		log:                  common.ChainLogger,
 		Genesis:              genesis,
@@ -51,7 +56,6 @@ func (c *chain) Init() error {
 	if err := c.checkGenesisCompatibility(); err != nil {
 		return err
 	}
-	types.SporkAddress = c.genesis.GetSporkAddress()
 	c.Register(c.accountPool)
 
 	frontierStore := c.GetFrontierMomentumStore()
@@ -19,6 +19,9 @@ type mockStable struct {
 func (m *mockStable) GetStableAccountDB(address types.Address) db.DB {
 	return db.NewMemDB()
 }
+func (m *mockStable) CheckPruned(hash types.Hash) error {
+	return nil
+}
 
 func newGenesisAccountBlocks(cfg *GenesisConfig) chain.AccountPool {
 	pool := chain.NewAccountPool(new(mockStable))
@@ -40,6 +43,10 @@ func newGenesisAccountBlocks(cfg *GenesisConfig) chain.AccountPool {
 		pool.AddAccountBlockTransaction(changes, genesisSporkContractConfig(cfg))
 		alreadySet[types.SporkContract] = struct{}{}
 	}
+	if cfg.AuthorityConfig != nil {
+		pool.AddAccountBlockTransaction(changes, genesisAuthorityContractConfig(cfg))
+		alreadySet[types.AuthorityContract] = struct{}{}
+	}
 
 	list := genesisBalanceBlocksConfig(cfg, alreadySet)
 	for _, el := range list {
@@ -159,6 +166,25 @@ func genesisSporkContractConfig(cfg *GenesisConfig) *nom.AccountBlockTransaction
 
 	return wrap(cfg, context)
 }
+func genesisAuthorityContractConfig(cfg *GenesisConfig) *nom.AccountBlockTransaction {
+	config := cfg.AuthorityConfig
+	context, contextStorage := newContext(types.AuthorityContract)
+
+	for _, entry := range config.Authorities {
+		authority := *entry
+		if authority.RegistrationTime == 0 {
+			authority.RegistrationTime = cfg.GenesisTimestampSec
+		}
+		common.DealWithErr(authority.Save(contextStorage))
+	}
+	admins := &definition.AuthorityAdmins{
+		Admins:    config.Admins,
+		Threshold: config.Threshold,
+	}
+	common.DealWithErr(admins.Save(contextStorage))
+
+	return wrap(cfg, context)
+}
 func genesisBalanceBlocksConfig(cfg *GenesisConfig, alreadySet map[types.Address]interface{}) []*nom.AccountBlockTransaction {
 	list := make([]*nom.AccountBlockTransaction, 0, len(cfg.GenesisBlocks.Blocks))
 	for _, genesisBlock := range cfg.GenesisBlocks.Blocks {
@@ -0,0 +1,90 @@
+package genesis
+
+import (
+	"fmt"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+// consensusMode returns the consensus mode selected by the HyperQube section of the genesis
+func (g *GenesisConfig) consensusMode() constants.ConsensusMode {
+	if hq, err := g.GetHyperQubeConfig(); err == nil && hq != nil {
+		if mode, err := constants.ParseConsensusMode(hq.ConsensusMode); err == nil {
+			return mode
+		}
+	}
+	return constants.ProofOfStake
+}
+
+func CheckAuthority(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateAuthority(g, errs)
+	return errs.first()
+}
+func validateAuthority(g *GenesisConfig, errs *genesisErrors) {
+	ac := g.AuthorityConfig
+	if g.consensusMode() != constants.ProofOfAuthority {
+		if ac != nil {
+			errs.errorf("AuthorityConfig", "can only be used with the %v consensus mode", constants.ProofOfAuthority)
+		}
+		return
+	}
+	if ac == nil {
+		errs.errorf("AuthorityConfig", "missing for the %v consensus mode", constants.ProofOfAuthority)
+		return
+	}
+	if g.EmbeddedContracts != nil {
+		enabled := false
+		for _, address := range g.EmbeddedContracts.Enabled {
+			enabled = enabled || address == types.AuthorityContract
+		}
+		if !enabled {
+			errs.errorf("EmbeddedContracts.Enabled", "must include the authority contract %v for the %v consensus mode", types.AuthorityContract, constants.ProofOfAuthority)
+		}
+	}
+
+	if len(ac.Authorities) == 0 || len(ac.Authorities) > constants.AuthorityMaxNum {
+		errs.errorf("AuthorityConfig.Authorities", "expected between 1 and %v authorities but got %v", constants.AuthorityMaxNum, len(ac.Authorities))
+	}
+	names := make(map[string]bool, len(ac.Authorities))
+	producers := make(map[types.Address]bool, len(ac.Authorities))
+	for index, authority := range ac.Authorities {
+		path := fmt.Sprintf("AuthorityConfig.Authorities[%v]", index)
+		if authority == nil {
+			errs.errorf(path, "nil authority")
+			continue
+		}
+		if len(authority.Name) == 0 || len(authority.Name) > constants.PillarNameLengthMax {
+			errs.errorf(path+".Name", "expected between 1 and %v characters but got %q", constants.PillarNameLengthMax, authority.Name)
+		} else if !constants.PillarNameRegExp.MatchString(authority.Name) {
+			errs.errorf(path+".Name", "invalid name %q, expected alphanumeric characters separated by single '-', '.' or '_'", authority.Name)
+		} else if names[authority.Name] {
+			errs.errorf(path+".Name", "duplicate name %v", authority.Name)
+		}
+		if authority.ProducerAddress.IsZero() || types.IsEmbeddedAddress(authority.ProducerAddress) {
+			errs.errorf(path+".ProducerAddress", "invalid producer address %v", authority.ProducerAddress)
+		} else if producers[authority.ProducerAddress] {
+			errs.errorf(path+".ProducerAddress", "duplicate producer address %v", authority.ProducerAddress)
+		}
+		names[authority.Name] = true
+		producers[authority.ProducerAddress] = true
+	}
+
+	if len(ac.Admins) == 0 || len(ac.Admins) > constants.AuthorityAdminsMaxNum {
+		errs.errorf("AuthorityConfig.Admins", "expected between 1 and %v admins but got %v", constants.AuthorityAdminsMaxNum, len(ac.Admins))
+	}
+	admins := make(map[types.Address]bool, len(ac.Admins))
+	for index, admin := range ac.Admins {
+		path := fmt.Sprintf("AuthorityConfig.Admins[%v]", index)
+		if admin.IsZero() {
+			errs.errorf(path, "zero address")
+		} else if admins[admin] {
+			errs.errorf(path, "duplicate admin %v", admin)
+		}
+		admins[admin] = true
+	}
+	if ac.Threshold == 0 || int(ac.Threshold) > len(ac.Admins) {
+		errs.errorf("AuthorityConfig.Threshold", "expected between 1 and the number of admins %v but got %v", len(ac.Admins), ac.Threshold)
+	}
+}
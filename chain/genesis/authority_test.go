@@ -0,0 +1,117 @@
+package genesis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+var (
+	authorityProducer1 = types.ParseAddressPanic("z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg")
+	authorityProducer2 = types.ParseAddressPanic("z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv")
+	authorityAdmin     = types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+)
+
+func newAuthorityTestConfig(t *testing.T) *GenesisConfig {
+	config := newHyperQubeTestConfig(t, "", &HyperQubeConfig{
+		ZnnTokenStandard:  types.ZnnTokenStandard,
+		QsrTokenStandard:  types.QsrTokenStandard,
+		ElectionAlgorithm: "UNIFORM",
+		BlockTime:         10,
+		ConsensusMode:     "POA",
+	})
+	config.AuthorityConfig = &AuthorityContractConfig{
+		Authorities: []*definition.AuthorityInfo{
+			{Name: "authority-1", ProducerAddress: authorityProducer1},
+			{Name: "authority-2", ProducerAddress: authorityProducer2},
+		},
+		Admins:    []types.Address{authorityAdmin},
+		Threshold: 1,
+	}
+	return config
+}
+
+func expectAuthorityError(t *testing.T, config *GenesisConfig, expected string) {
+	err := CheckAuthority(config)
+	if err == nil {
+		t.Fatalf("expected error containing %q but got nil", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q but got %q", expected, err)
+	}
+}
+
+func TestAuthorityConsensusMode(t *testing.T) {
+	config := newAuthorityTestConfig(t)
+	common.FailIfErr(t, CheckHyperQube(config))
+	common.FailIfErr(t, CheckAuthority(config))
+
+	params := NewGenesis(config).GetChainParams()
+	common.ExpectString(t, params.Consensus.Mode.String(), "POA")
+	common.ExpectJson(t, params.IsContractEnabled(types.AuthorityContract), `true`)
+
+	// the mode defaults to proof of stake, without the authority contract
+	config.HyperQube.ConsensusMode = ""
+	config.AuthorityConfig = nil
+	params = NewGenesis(config).GetChainParams()
+	common.ExpectString(t, params.Consensus.Mode.String(), "POS")
+	common.ExpectJson(t, params.IsContractEnabled(types.AuthorityContract), `false`)
+
+	config.HyperQube.ConsensusMode = "DPOS"
+	expectHyperQubeError(t, config, "HyperQube.ConsensusMode: unknown consensus mode \"DPOS\"")
+}
+
+func TestAuthorityConfigInvalid(t *testing.T) {
+	config := newAuthorityTestConfig(t)
+	config.HyperQube.ConsensusMode = ""
+	expectAuthorityError(t, config, "AuthorityConfig: can only be used with the POA consensus mode")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig = nil
+	expectAuthorityError(t, config, "AuthorityConfig: missing for the POA consensus mode")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Authorities = nil
+	expectAuthorityError(t, config, "AuthorityConfig.Authorities: expected between 1 and 64 authorities but got 0")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Authorities[1].Name = "Authority Two"
+	expectAuthorityError(t, config, "AuthorityConfig.Authorities[1].Name: invalid name \"Authority Two\", expected alphanumeric characters separated by single '-', '.' or '_'")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Authorities[1].Name = "authority-1"
+	expectAuthorityError(t, config, "AuthorityConfig.Authorities[1].Name: duplicate name authority-1")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Authorities[1].ProducerAddress = authorityProducer1
+	expectAuthorityError(t, config, "AuthorityConfig.Authorities[1].ProducerAddress: duplicate producer address z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Authorities[0].ProducerAddress = types.PillarContract
+	expectAuthorityError(t, config, "AuthorityConfig.Authorities[0].ProducerAddress: invalid producer address z1qxemdeddedxpyllarxxxxxxxxxxxxxxxsy3fmg")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Admins = append(config.AuthorityConfig.Admins, authorityAdmin)
+	expectAuthorityError(t, config, "AuthorityConfig.Admins[1]: duplicate admin z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+
+	config = newAuthorityTestConfig(t)
+	config.AuthorityConfig.Threshold = 2
+	expectAuthorityError(t, config, "AuthorityConfig.Threshold: expected between 1 and the number of admins 1 but got 2")
+
+	config = newAuthorityTestConfig(t)
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PlasmaContract}}
+	expectAuthorityError(t, config, "EmbeddedContracts.Enabled: must include the authority contract z1qxemdeddedxauth0rytyxxxxxxxxxxxxx9r2t0 for the POA consensus mode")
+}
+
+func TestAuthorityConfigChangesGenesisHash(t *testing.T) {
+	config := newAuthorityTestConfig(t)
+	hash := NewGenesis(config).GetGenesisMomentum().Hash
+
+	config.AuthorityConfig.Authorities = config.AuthorityConfig.Authorities[:1]
+	if NewGenesis(config).GetGenesisMomentum().Hash == hash {
+		t.Fatalf("expected the authorities to change the genesis hash")
+	}
+}
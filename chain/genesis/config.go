@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"os"
 
+	"github.com/pkg/errors"
+
 	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
@@ -22,39 +24,48 @@ func MakeEmbeddedGenesisConfig() (store.Genesis, error) {
 	return NewGenesis(embeddedGenesis), nil
 }
 
-func ReadGenesisConfigFromFile(genesisFile string) (store.Genesis, error) {
+// ReadGenesisConfig decodes a genesis file without checking it.
+// The cause of the returned errors is one of ErrInvalidGenesisPath, ErrIncompleteGenesisJson or ErrInvalidGenesisJson.
+func ReadGenesisConfig(genesisFile string) (*GenesisConfig, error) {
+	file, err := os.Open(genesisFile)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidGenesisPath, "%v", err)
+	}
+	defer file.Close()
+
+	config := new(GenesisConfig)
+	if err := json.NewDecoder(file).Decode(config); err != nil {
+		if err.Error() == "unexpected EOF" || err.Error() == "EOF" {
+			return nil, errors.Wrapf(ErrIncompleteGenesisJson, "%v", err)
+		} else {
+			return nil, errors.Wrapf(ErrInvalidGenesisJson, "%v", err)
+		}
+	}
+	return config, nil
+}
+
+func ReadGenesisConfigFromFile(genesisFile string) (genesis store.Genesis, err error) {
 	defer func() {
-		if err := recover(); err != nil {
-			log.Crit("invalid genesis file", "method", "readGenesis", "genesisFile", genesisFile)
+		if r := recover(); r != nil {
+			log.Crit("invalid genesis file", "method", "readGenesis", "reason", r, "genesisFile", genesisFile)
+			genesis, err = nil, ErrInvalidGenesisConfig
 		}
 	}()
 
-	var config *GenesisConfig
-
 	if len(genesisFile) > 0 {
-		file, err := os.Open(genesisFile)
+		config, err := ReadGenesisConfig(genesisFile)
 		if err != nil {
 			log.Crit("invalid genesis file", "method", "readGenesis", "reason", err, "genesisFile", genesisFile)
-			return nil, ErrInvalidGenesisPath
+			return nil, errors.Cause(err)
 		}
-		defer file.Close()
 
-		config = new(GenesisConfig)
-		if err := json.NewDecoder(file).Decode(config); err != nil {
-			log.Crit("invalid genesis file", "method", "readGenesis", "reason", err, "genesisFile", genesisFile)
-			if err.Error() == "unexpected EOF" || err.Error() == "EOF" {
-				return nil, ErrIncompleteGenesisJson
-			} else {
-				return nil, ErrInvalidGenesisJson
+		if errs := ValidateGenesis(config); len(errs) != 0 {
+			for _, err := range errs {
+				log.Crit("invalid genesis file", "method", "readGenesis", "reason", err, "genesisFile", genesisFile)
 			}
-		}
-
-		applyHyperQubeConfig(config)
-
-		if err := CheckGenesis(config); err != nil {
-			log.Crit("invalid genesis file", "method", "readGenesis", "reason", err, "genesisFile", genesisFile)
 			return nil, ErrInvalidGenesisConfig
 		}
+
 		return NewGenesis(config), nil
 	} else {
 		return nil, nil
@@ -67,12 +78,20 @@ type GenesisConfig struct {
 	GenesisTimestampSec int64
 	SporkAddress        *types.Address
 
+	HyperQube          *HyperQubeConfig
+	ContractParameters *ContractParametersConfig
+	EmbeddedContracts  *EmbeddedContractsConfig
+	RewardSchedule     *RewardScheduleConfig
+
 	PillarConfig *PillarContractConfig
 	TokenConfig  *TokenContractConfig
 	PlasmaConfig *PlasmaContractConfig
 	SwapConfig   *SwapContractConfig
 	SporkConfig  *SporkConfig
 
+	// AuthorityConfig is required by, and only allowed for, the POA consensus mode
+	AuthorityConfig *AuthorityContractConfig
+
 	GenesisBlocks *GenesisBlocksConfig
 }
 
@@ -106,3 +125,11 @@ type GenesisBlockConfig struct {
 type SporkConfig struct {
 	Sporks []*definition.Spork
 }
+
+// AuthorityContractConfig defines the producers of a proof of authority chain.
+// Later changes to the authorities require Threshold of the Admins to send the same call to the AuthorityContract.
+type AuthorityContractConfig struct {
+	Authorities []*definition.AuthorityInfo
+	Admins      []types.Address
+	Threshold   uint8
+}
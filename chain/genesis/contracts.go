@@ -0,0 +1,107 @@
+package genesis
+
+import (
+	"fmt"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+// EmbeddedContractsConfig selects the embedded contracts of a chain.
+type EmbeddedContractsConfig struct {
+	// Enabled lists the embedded contracts available on this chain.
+	// Send blocks to the other embedded contracts are rejected.
+	Enabled []types.Address
+	// ActiveSporks lists the ids of the implemented sporks which are active since the genesis momentum
+	ActiveSporks []types.Hash
+}
+
+// contractDependencies lists the embedded contracts which send blocks to other embedded contracts.
+// A send to a disabled contract fails, so each contract can only be enabled together with its dependencies.
+var contractDependencies = []struct {
+	contract   types.Address
+	dependency types.Address
+	reason     string
+}{
+	{types.PillarContract, types.TokenContract, "mint the rewards and burn the registration QSR"},
+	{types.SentinelContract, types.TokenContract, "mint the rewards"},
+	{types.StakeContract, types.TokenContract, "mint the rewards"},
+	{types.LiquidityContract, types.TokenContract, "mint the rewards"},
+	{types.LiquidityContract, types.AcceleratorContract, "donate to the accelerator"},
+	{types.SwapContract, types.TokenContract, "mint the swapped tokens"},
+	{types.BridgeContract, types.TokenContract, "mint and burn the wrapped tokens"},
+}
+
+func CheckEmbeddedContracts(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateEmbeddedContracts(g, errs)
+	return errs.first()
+}
+func validateEmbeddedContracts(g *GenesisConfig, errs *genesisErrors) {
+	ec := g.EmbeddedContracts
+	if ec == nil {
+		return
+	}
+
+	enabled := make(map[types.Address]bool)
+	for index, address := range ec.Enabled {
+		path := fmt.Sprintf("EmbeddedContracts.Enabled[%v]", index)
+		known := false
+		for _, contract := range types.EmbeddedContracts {
+			if contract == address {
+				known = true
+				break
+			}
+		}
+		if !known {
+			errs.errorf(path, "%v is not an embedded contract", address)
+		} else if enabled[address] {
+			errs.errorf(path, "duplicate contract %v", address)
+		}
+		enabled[address] = true
+	}
+
+	active := make(map[types.Hash]bool)
+	for index, id := range ec.ActiveSporks {
+		path := fmt.Sprintf("EmbeddedContracts.ActiveSporks[%v]", index)
+		if _, ok := types.ImplementedSporksMap[id]; !ok {
+			errs.errorf(path, "spork %v is not implemented", id)
+		} else if active[id] {
+			errs.errorf(path, "duplicate spork %v", id)
+		}
+		active[id] = true
+	}
+
+	for _, dependency := range contractDependencies {
+		if !enabled[dependency.contract] || enabled[dependency.dependency] {
+			continue
+		}
+		for index, address := range ec.Enabled {
+			if address == dependency.contract {
+				errs.errorf(fmt.Sprintf("EmbeddedContracts.Enabled[%v]", index), "%v requires the contract %v to %v", address, dependency.dependency, dependency.reason)
+				break
+			}
+		}
+	}
+	if g.consensusMode() == constants.ProofOfStake && !enabled[types.PillarContract] {
+		errs.errorf("EmbeddedContracts.Enabled", "must include the pillar contract %v for the %v consensus mode", types.PillarContract, constants.ProofOfStake)
+	}
+}
+
+// applyEmbeddedContracts updates the chain params with the EmbeddedContracts section.
+// Must be called only after CheckGenesis succeeds.
+func applyEmbeddedContracts(config *GenesisConfig, params *constants.ChainParams) {
+	ec := config.EmbeddedContracts
+	if ec == nil {
+		return
+	}
+
+	params.EnabledContracts = make(map[types.Address]bool, len(ec.Enabled))
+	for _, address := range ec.Enabled {
+		params.EnabledContracts[address] = true
+	}
+	params.ActiveSporks = make(map[types.Hash]bool, len(ec.ActiveSporks))
+	for _, id := range ec.ActiveSporks {
+		params.ActiveSporks[id] = true
+	}
+}
@@ -0,0 +1,96 @@
+package genesis
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func expectEmbeddedContractsError(t *testing.T, config *GenesisConfig, expected string) {
+	err := CheckEmbeddedContracts(config)
+	if err == nil {
+		t.Fatalf("expected error containing %q but got nil", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q but got %q", expected, err)
+	}
+}
+
+func TestEmbeddedContractsFromJson(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	common.FailIfErr(t, json.Unmarshal([]byte(`
+{
+	"EmbeddedContracts": {
+		"Enabled": ["z1qxemdeddedxplasmaxxxxxxxxxxxxxxxxsctrp", "z1qxemdeddedxpyllarxxxxxxxxxxxxxxxsy3fmg", "z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0"],
+		"ActiveSporks": ["ceb7e3808ef17ea910adda2f3ab547be4cdfb54de8400ce3683258d06be1354b"]
+	}
+}`), config))
+	common.FailIfErr(t, CheckEmbeddedContracts(config))
+
+	params := NewGenesis(config).GetChainParams()
+	common.ExpectJson(t, params.IsContractEnabled(types.PillarContract), `true`)
+	common.ExpectJson(t, params.IsContractEnabled(types.SwapContract), `false`)
+	common.ExpectJson(t, params.ActiveSporks[types.HtlcSpork.SporkId], `true`)
+
+	// without the section every contract is enabled
+	defaults := NewGenesis(newHyperQubeTestConfig(t, "", nil)).GetChainParams()
+	common.ExpectJson(t, defaults.IsContractEnabled(types.SwapContract), `true`)
+}
+
+func TestEmbeddedContractsInvalid(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")}}
+	expectEmbeddedContractsError(t, config, "EmbeddedContracts.Enabled[0]: z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz is not an embedded contract")
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PlasmaContract, types.PlasmaContract}}
+	expectEmbeddedContractsError(t, config, "EmbeddedContracts.Enabled[1]: duplicate contract z1qxemdeddedxplasmaxxxxxxxxxxxxxxxxsctrp")
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{ActiveSporks: []types.Hash{types.HexToHashPanic("eedcf4003fedfa69a0494e8b09c156f70c3e790af563642d0222514c3078966f")}}
+	expectEmbeddedContractsError(t, config, "EmbeddedContracts.ActiveSporks[0]: spork eedcf4003fedfa69a0494e8b09c156f70c3e790af563642d0222514c3078966f is not implemented")
+}
+
+// - the contracts which send blocks to other embedded contracts require them
+// - the POS consensus mode requires the pillar contract
+func TestEmbeddedContractsDependencies(t *testing.T) {
+	config := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(emptyGenesisJsonStr, config))
+	expectGenesisError := func(expected string) {
+		err := CheckGenesis(config)
+		if err == nil {
+			t.Fatalf("expected error containing %q but got nil", expected)
+		}
+		common.ExpectString(t, err.Error(), expected)
+	}
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PillarContract, types.PlasmaContract, types.TokenContract}}
+	common.FailIfErr(t, CheckGenesis(config))
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PlasmaContract, types.PillarContract, types.StakeContract}}
+	expectGenesisError("EmbeddedContracts.Enabled[1]: z1qxemdeddedxpyllarxxxxxxxxxxxxxxxsy3fmg requires the contract z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0 to mint the rewards and burn the registration QSR")
+	common.ExpectJson(t, ValidateGenesis(config)[1], `
+{
+	"Path": "EmbeddedContracts.Enabled[2]",
+	"Message": "z1qxemdeddedxstakexxxxxxxxxxxxxxxxjv8v62 requires the contract z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0 to mint the rewards"
+}`)
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PillarContract, types.TokenContract, types.LiquidityContract}}
+	expectGenesisError("EmbeddedContracts.Enabled[2]: z1qxemdeddedxlyquydytyxxxxxxxxxxxxflaaae requires the contract z1qxemdeddedxaccelerat0rxxxxxxxxxxp4tk22 to donate to the accelerator")
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PlasmaContract, types.TokenContract}}
+	expectGenesisError("EmbeddedContracts.Enabled: must include the pillar contract z1qxemdeddedxpyllarxxxxxxxxxxxxxxxsy3fmg for the POS consensus mode")
+}
+
+func TestEmbeddedContractsChangeGenesisHash(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	config.TokenConfig.Tokens = nil
+	common.ExpectString(t, NewGenesis(config).GetGenesisMomentum().Hash.String(), emptyHash.String())
+
+	config.EmbeddedContracts = &EmbeddedContractsConfig{Enabled: []types.Address{types.PlasmaContract}}
+	if NewGenesis(config).GetGenesisMomentum().Hash == emptyHash {
+		t.Fatalf("expected the EmbeddedContracts section to change the genesis hash")
+	}
+}
@@ -0,0 +1,461 @@
+package genesis
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+// ExportConfig selects what ExportGenesis copies from the state of an existing chain
+type ExportConfig struct {
+	ChainIdentifier     uint64
+	GenesisTimestampSec int64
+	// SporkAddress of the new chain, defaults to the spork address of the exported chain
+	SporkAddress *types.Address
+	// MinBalance drops the balances of regular addresses which are lower, staked ZNN included
+	MinBalance *big.Int
+	// Tokens lists the exported tokens, all the tokens are exported if empty
+	Tokens []types.ZenonTokenStandard
+}
+
+type exportedBalances map[types.Address]map[types.ZenonTokenStandard]*big.Int
+
+func (b exportedBalances) add(address types.Address, zts types.ZenonTokenStandard, amount *big.Int) {
+	list, ok := b[address]
+	if !ok {
+		list = make(map[types.ZenonTokenStandard]*big.Int)
+		b[address] = list
+	}
+	if total, ok := list[zts]; ok {
+		total.Add(total, amount)
+	} else {
+		list[zts] = new(big.Int).Set(amount)
+	}
+}
+
+// ExportGenesis creates the genesis of a new chain seeded with the state of momentumStore.
+//
+// The new genesis contains:
+//   - the balances of all the regular addresses, active stakes are returned to their owners
+//   - the active pillars with their delegations and the legacy pillar entries
+//   - the fusions, which keep the number of momentums left until they can be canceled
+//   - the definitions of the exported tokens, with the total supply of the exported balances
+//   - the HyperQube section and the enabled contracts and active sporks of the exported chain
+//   - the contract parameters and the reward schedule of the exported chain, if the new chain doesn't derive the same ones
+//   - the authorities and their admins for proof of authority chains
+//
+// The balances of the other embedded contracts (sentinels, accelerator, bridge, etc.) are not exported.
+func ExportGenesis(momentumStore store.Momentum, cfg *ExportConfig) (*GenesisConfig, error) {
+	params := momentumStore.GetChainParams()
+	znn, qsr := params.ZnnTokenStandard, params.QsrTokenStandard
+	frontier, err := momentumStore.GetFrontierMomentum()
+	if err != nil {
+		return nil, err
+	}
+	if frontier == nil {
+		return nil, errors.Errorf("no momentum to export")
+	}
+
+	includedTokens := make(map[types.ZenonTokenStandard]bool, len(cfg.Tokens))
+	for _, zts := range cfg.Tokens {
+		includedTokens[zts] = true
+	}
+	included := func(zts types.ZenonTokenStandard) bool {
+		return len(includedTokens) == 0 || includedTokens[zts]
+	}
+
+	balances := make(exportedBalances)
+	addresses, err := momentumStore.GetAccountAddresses()
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		if types.IsEmbeddedAddress(address) {
+			continue
+		}
+		balanceMap, err := momentumStore.GetAccountStore(address).GetBalanceMap()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get balances of %v", address)
+		}
+		for zts, amount := range balanceMap {
+			if included(zts) && amount.Sign() > 0 {
+				balances.add(address, zts, amount)
+			}
+		}
+	}
+
+	// the genesis can't define stake entries so the staked ZNN goes back to its owner
+	if included(znn) {
+		stakeStorage := momentumStore.GetAccountStore(types.StakeContract).Storage()
+		err := definition.IterateStakeEntries(stakeStorage, func(stake *definition.StakeInfo) error {
+			if stake.RevokeTime == 0 {
+				balances.add(stake.StakeAddress, znn, stake.Amount)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get stake entries")
+		}
+	}
+
+	if cfg.MinBalance != nil {
+		for address, list := range balances {
+			for zts, amount := range list {
+				if amount.Cmp(cfg.MinBalance) < 0 {
+					delete(list, zts)
+				}
+			}
+			if len(list) == 0 {
+				delete(balances, address)
+			}
+		}
+	}
+
+	pillarConfig := &PillarContractConfig{
+		Pillars:       []*definition.PillarInfo{},
+		Delegations:   []*definition.DelegationInfo{},
+		LegacyEntries: []*definition.LegacyPillarEntry{},
+	}
+	if included(znn) {
+		pillarStorage := momentumStore.GetAccountStore(types.PillarContract).Storage()
+		pillars, err := definition.GetPillarsList(pillarStorage, true, definition.AnyPillarType)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get pillars")
+		}
+		names := make(map[string]bool, len(pillars))
+		for _, pillar := range pillars {
+			pillar.RegistrationTime = cfg.GenesisTimestampSec
+			names[pillar.Name] = true
+			pillarConfig.Pillars = append(pillarConfig.Pillars, pillar)
+			balances.add(types.PillarContract, znn, pillar.Amount)
+		}
+
+		delegations, err := definition.GetDelegationsList(pillarStorage)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get delegations")
+		}
+		for _, delegation := range delegations {
+			if names[delegation.Name] {
+				pillarConfig.Delegations = append(pillarConfig.Delegations, delegation)
+			}
+		}
+
+		legacyEntries, err := definition.GetLegacyPillarList(pillarStorage)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get legacy pillar entries")
+		}
+		pillarConfig.LegacyEntries = append(pillarConfig.LegacyEntries, legacyEntries...)
+	}
+
+	plasmaConfig := &PlasmaContractConfig{
+		Fusions: []*definition.FusionInfo{},
+	}
+	if included(qsr) {
+		plasmaStorage := momentumStore.GetAccountStore(types.PlasmaContract).Storage()
+		err := definition.IterateFusionEntries(plasmaStorage, func(fusion *definition.FusionInfo) error {
+			// heights restart from the genesis momentum
+			expirationHeight := uint64(1)
+			if fusion.ExpirationHeight > frontier.Height {
+				expirationHeight += fusion.ExpirationHeight - frontier.Height
+			}
+			fusion.ExpirationHeight = expirationHeight
+			plasmaConfig.Fusions = append(plasmaConfig.Fusions, fusion)
+			balances.add(types.PlasmaContract, qsr, fusion.Amount)
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get fusions")
+		}
+	}
+
+	supply := make(map[types.ZenonTokenStandard]*big.Int)
+	blockAddresses := make([]types.Address, 0, len(balances))
+	for address, list := range balances {
+		blockAddresses = append(blockAddresses, address)
+		for zts, amount := range list {
+			if total, ok := supply[zts]; ok {
+				total.Add(total, amount)
+			} else {
+				supply[zts] = new(big.Int).Set(amount)
+			}
+		}
+	}
+	sort.Slice(blockAddresses, func(i, j int) bool {
+		return bytes.Compare(blockAddresses[i].Bytes(), blockAddresses[j].Bytes()) < 0
+	})
+	blocks := make([]*GenesisBlockConfig, 0, len(blockAddresses))
+	for _, address := range blockAddresses {
+		blocks = append(blocks, &GenesisBlockConfig{
+			Address:     address,
+			BalanceList: balances[address],
+		})
+	}
+
+	tokenStorage := momentumStore.GetAccountStore(types.TokenContract).Storage()
+	tokenInfos, err := definition.GetTokenInfoList(tokenStorage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tokens")
+	}
+	tokens := make([]*definition.TokenInfo, 0, len(supply))
+	for _, token := range tokenInfos {
+		total, ok := supply[token.TokenStandard]
+		if !ok {
+			continue
+		}
+		token.TotalSupply = total
+		if token.MaxSupply.Cmp(total) < 0 {
+			token.MaxSupply = new(big.Int).Set(total)
+		}
+		tokens = append(tokens, token)
+	}
+
+	sporkAddress := cfg.SporkAddress
+	if sporkAddress == nil {
+		sporkAddress = params.SporkAddress
+	}
+
+	embeddedContracts, err := exportEmbeddedContracts(momentumStore)
+	if err != nil {
+		return nil, err
+	}
+	authorityConfig, err := exportAuthorities(momentumStore, cfg)
+	if err != nil {
+		return nil, err
+	}
+	consensus, err := exportConsensus(momentumStore, frontier.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &GenesisConfig{
+		ChainIdentifier:     cfg.ChainIdentifier,
+		ExtraData:           "",
+		GenesisTimestampSec: cfg.GenesisTimestampSec,
+		SporkAddress:        sporkAddress,
+		HyperQube:           exportHyperQube(params, consensus),
+		EmbeddedContracts:   embeddedContracts,
+		PillarConfig:        pillarConfig,
+		TokenConfig: &TokenContractConfig{
+			Tokens: tokens,
+		},
+		PlasmaConfig: plasmaConfig,
+		SwapConfig: &SwapContractConfig{
+			Entries: []*definition.SwapAssets{},
+		},
+		SporkConfig: &SporkConfig{
+			Sporks: []*definition.Spork{},
+		},
+		GenesisBlocks: &GenesisBlocksConfig{
+			Blocks: blocks,
+		},
+		AuthorityConfig: authorityConfig,
+	}
+	derived := newChainParams(config)
+	config.ContractParameters = exportContractParameters(params, derived)
+	config.RewardSchedule = exportRewardSchedule(params, derived)
+	return config, nil
+}
+
+// exportContractParameters returns the contract parameters of the exported chain, nil if they match the derived ones
+func exportContractParameters(params *constants.ChainParams, derived *constants.ChainParams) *ContractParametersConfig {
+	seconds := func(value int64) *int64 {
+		return &value
+	}
+	threshold := params.VoteAcceptanceThreshold
+	p := &ContractParametersConfig{
+		PillarStakeAmount:              new(big.Int).Set(params.PillarStakeAmount),
+		PillarQsrStakeBaseAmount:       new(big.Int).Set(params.PillarQsrStakeBaseAmount),
+		PillarQsrStakeIncreaseAmount:   new(big.Int).Set(params.PillarQsrStakeIncreaseAmount),
+		PillarEpochLockTime:            seconds(params.PillarEpochLockTime),
+		PillarEpochRevokeTime:          seconds(params.PillarEpochRevokeTime),
+		SentinelZnnRegisterAmount:      new(big.Int).Set(params.SentinelZnnRegisterAmount),
+		SentinelQsrDepositAmount:       new(big.Int).Set(params.SentinelQsrDepositAmount),
+		SentinelLockTimeWindow:         seconds(params.SentinelLockTimeWindow),
+		SentinelRevokeTimeWindow:       seconds(params.SentinelRevokeTimeWindow),
+		StakeTimeUnitSec:               seconds(params.StakeTimeUnitSec),
+		StakeMinAmount:                 new(big.Int).Set(params.StakeMinAmount),
+		FuseMinAmount:                  new(big.Int).Set(params.FuseMinAmount),
+		TokenIssueAmount:               new(big.Int).Set(params.TokenIssueAmount),
+		ProjectCreationAmount:          new(big.Int).Set(params.ProjectCreationAmount),
+		ProjectZnnMaximumFunds:         new(big.Int).Set(params.ProjectZnnMaximumFunds),
+		ProjectQsrMaximumFunds:         new(big.Int).Set(params.ProjectQsrMaximumFunds),
+		VoteAcceptanceThreshold:        &threshold,
+		AcceleratorProjectVotingPeriod: seconds(params.AcceleratorProjectVotingPeriod),
+	}
+
+	for _, amount := range p.amounts(derived) {
+		if amount.value.Cmp(*amount.param) != 0 {
+			return p
+		}
+	}
+	for _, time := range p.times(derived) {
+		if *time.value != *time.param {
+			return p
+		}
+	}
+	if threshold != derived.VoteAcceptanceThreshold {
+		return p
+	}
+	return nil
+}
+
+func rewardScheduleOf(params *constants.ChainParams) *RewardScheduleConfig {
+	copyAmounts := func(amounts []*big.Int) []*big.Int {
+		copied := make([]*big.Int, len(amounts))
+		for i, amount := range amounts {
+			copied[i] = new(big.Int).Set(amount)
+		}
+		return copied
+	}
+	return &RewardScheduleConfig{
+		TickDurationInEpochs:           params.RewardTickDurationInEpochs,
+		ZnnPerEpoch:                    copyAmounts(params.NetworkZnnRewardConfig),
+		QsrPerEpoch:                    copyAmounts(params.NetworkQsrRewardConfig),
+		DelegationZnnPercentage:        params.DelegationZnnRewardPercentage,
+		MomentumProducingZnnPercentage: params.MomentumProducingZnnRewardPercentage,
+		SentinelZnnPercentage:          params.SentinelZnnRewardPercentage,
+		LiquidityZnnPercentage:         params.LiquidityZnnRewardPercentage,
+		StakingQsrPercentage:           params.StakingQsrRewardPercentage,
+		SentinelQsrPercentage:          params.SentinelQsrRewardPercentage,
+		LiquidityQsrPercentage:         params.LiquidityQsrRewardPercentage,
+	}
+}
+
+// exportRewardSchedule returns the reward schedule of the exported chain, nil if it matches the derived one
+func exportRewardSchedule(params *constants.ChainParams, derived *constants.ChainParams) *RewardScheduleConfig {
+	equalAmounts := func(a, b []*big.Int) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i].Cmp(b[i]) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	rs, other := rewardScheduleOf(params), rewardScheduleOf(derived)
+	if rs.TickDurationInEpochs == other.TickDurationInEpochs &&
+		equalAmounts(rs.ZnnPerEpoch, other.ZnnPerEpoch) &&
+		equalAmounts(rs.QsrPerEpoch, other.QsrPerEpoch) &&
+		rs.DelegationZnnPercentage == other.DelegationZnnPercentage &&
+		rs.MomentumProducingZnnPercentage == other.MomentumProducingZnnPercentage &&
+		rs.SentinelZnnPercentage == other.SentinelZnnPercentage &&
+		rs.LiquidityZnnPercentage == other.LiquidityZnnPercentage &&
+		rs.StakingQsrPercentage == other.StakingQsrPercentage &&
+		rs.SentinelQsrPercentage == other.SentinelQsrPercentage &&
+		rs.LiquidityQsrPercentage == other.LiquidityQsrPercentage {
+		return nil
+	}
+	return rs
+}
+
+// exportConsensus returns the consensus parameters of the chain params changed by the consensus sporks enforced up to height,
+// since the sporks are not exported
+func exportConsensus(momentumStore store.Momentum, height uint64) (*constants.Consensus, error) {
+	consensus := *momentumStore.GetChainParams().Consensus
+	sporks, err := momentumStore.GetAllDefinedSporks()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sporks, func(i, j int) bool {
+		if sporks[i].EnforcementHeight != sporks[j].EnforcementHeight {
+			return sporks[i].EnforcementHeight < sporks[j].EnforcementHeight
+		}
+		return bytes.Compare(sporks[i].Id.Bytes(), sporks[j].Id.Bytes()) < 0
+	})
+	for _, spork := range sporks {
+		if !spork.Activated || spork.EnforcementHeight > height {
+			continue
+		}
+		parameters, err := momentumStore.GetSporkConsensusParameters(spork.Id)
+		if err != nil {
+			return nil, err
+		}
+		if parameters == nil {
+			continue
+		}
+		consensus.NodeCount = parameters.NodeCount
+		consensus.RandCount = parameters.RandCount
+	}
+	return &consensus, nil
+}
+
+// exportHyperQube returns the HyperQube section of the exported chain, nil if it uses the mainnet tokens and consensus
+func exportHyperQube(params *constants.ChainParams, consensus *constants.Consensus) *HyperQubeConfig {
+	if params.ZnnTokenStandard == types.ZnnTokenStandard && params.QsrTokenStandard == types.QsrTokenStandard && *consensus == *constants.ConsensusConfig {
+		return nil
+	}
+	hq := &HyperQubeConfig{
+		ZnnTokenStandard:  params.ZnnTokenStandard,
+		QsrTokenStandard:  params.QsrTokenStandard,
+		ElectionAlgorithm: consensus.Algorithm.String(),
+		BlockTime:         consensus.BlockTime,
+		NodeCount:         consensus.NodeCount,
+		RandCount:         consensus.RandCount,
+	}
+	if consensus.Mode == constants.ProofOfAuthority {
+		hq.ConsensusMode = consensus.Mode.String()
+	}
+	return hq
+}
+
+// exportAuthorities returns the authorities and the admins of proof of authority chains, nil otherwise.
+// Pending proposals are not exported.
+func exportAuthorities(momentumStore store.Momentum, cfg *ExportConfig) (*AuthorityContractConfig, error) {
+	if momentumStore.GetChainParams().Consensus.Mode != constants.ProofOfAuthority {
+		return nil, nil
+	}
+	authorityStorage := momentumStore.GetAccountStore(types.AuthorityContract).Storage()
+	authorities, err := definition.GetAuthorityList(authorityStorage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get authorities")
+	}
+	for _, authority := range authorities {
+		authority.RegistrationTime = cfg.GenesisTimestampSec
+	}
+	admins, err := definition.GetAuthorityAdmins(authorityStorage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get authority admins")
+	}
+	return &AuthorityContractConfig{
+		Authorities: authorities,
+		Admins:      admins.Admins,
+		Threshold:   admins.Threshold,
+	}, nil
+}
+
+// exportEmbeddedContracts carries the enabled contracts and the sporks active at the exported height to the new chain,
+// since the spork entries and their enforcement heights are not exported
+func exportEmbeddedContracts(momentumStore store.Momentum) (*EmbeddedContractsConfig, error) {
+	params := momentumStore.GetChainParams()
+	config := &EmbeddedContractsConfig{
+		Enabled:      []types.Address{},
+		ActiveSporks: []types.Hash{},
+	}
+	for _, address := range types.EmbeddedContracts {
+		if params.IsContractEnabled(address) {
+			config.Enabled = append(config.Enabled, address)
+		}
+	}
+	for id := range types.ImplementedSporksMap {
+		active, err := momentumStore.IsSporkActive(&types.ImplementedSpork{SporkId: id})
+		if err != nil {
+			return nil, err
+		}
+		if active {
+			config.ActiveSporks = append(config.ActiveSporks, id)
+		}
+	}
+	sort.Slice(config.ActiveSporks, func(i, j int) bool {
+		return bytes.Compare(config.ActiveSporks[i].Bytes(), config.ActiveSporks[j].Bytes()) < 0
+	})
+	return config, nil
+}
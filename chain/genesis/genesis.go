@@ -4,23 +4,39 @@ import (
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 type genesis struct {
 	config              *GenesisConfig
 	momentumTransaction *nom.MomentumTransaction
+	chainParams         *constants.ChainParams
 }
 
 func NewGenesis(config *GenesisConfig) store.Genesis {
+	chainParams := newChainParams(config)
 	accountPool := newGenesisAccountBlocks(config)
-	momentumTransaction := newGenesisMomentum(config, accountPool)
+	momentumTransaction := newGenesisMomentum(config, accountPool, chainParams)
 
 	return &genesis{
 		config:              config,
 		momentumTransaction: momentumTransaction,
+		chainParams:         chainParams,
 	}
 }
 
+// newChainParams derives the chain params from the default ones and the genesis sections
+func newChainParams(config *GenesisConfig) *constants.ChainParams {
+	params := constants.DefaultChainParams()
+	params.SporkAddress = config.SporkAddress
+	applyHyperQubeConfig(config, params)
+	applyTokenDecimals(config, params)
+	applyContractParameters(config, params)
+	applyEmbeddedContracts(config, params)
+	applyRewardSchedule(config, params)
+	return params
+}
+
 func (g *genesis) ChainIdentifier() uint64 {
 	return g.config.ChainIdentifier
 }
@@ -36,3 +52,6 @@ func (g *genesis) GetGenesisTransaction() *nom.MomentumTransaction {
 func (g *genesis) GetSporkAddress() *types.Address {
 	return g.config.SporkAddress
 }
+func (g *genesis) GetChainParams() *constants.ChainParams {
+	return g.chainParams
+}
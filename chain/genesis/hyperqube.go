@@ -1,59 +1,273 @@
 package genesis
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
+	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus"
 	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 )
 
-func applyHyperQubeConfig(config *GenesisConfig) {
+const (
+	legacyHyperQubePrefix = "HYPERQUBE"
+	secondsInHour         = 3600
+)
+
+// HyperQubeConfig defines the chain specific settings of a HyperQube.
+// Zero values for NodeCount and RandCount keep the mainnet defaults.
+type HyperQubeConfig struct {
+	// ZnnTokenStandard and QsrTokenStandard select which tokens from TokenConfig play the role of ZNN and QSR
+	ZnnTokenStandard types.ZenonTokenStandard
+	QsrTokenStandard types.ZenonTokenStandard
+
+	ElectionAlgorithm string // ElectionAlgorithm is the name of a registered algorithm, e.g. MAINNET, UNIFORM or UNIFORM_HASH
+	BlockTime         int64  // BlockTime is the interval in seconds between 2 momentums, must divide an hour
+	NodeCount         uint8  // NodeCount of pillars in an election tick
+	RandCount         uint8  // RandCount of pillars which are chosen randomly in an election tick
+
+	// ConsensusMode is POS or POA, empty keeps the pillar delegations (POS).
+	// POA chains take their producers from AuthorityConfig instead.
+	ConsensusMode string `json:",omitempty"`
+}
+
+// isLegacyHyperQube returns true if the genesis uses the `HYPERQUBE <name> <algorithm> <block-time>` ExtraData form
+func (g *GenesisConfig) isLegacyHyperQube() bool {
+	args := strings.Fields(g.ExtraData)
+	return len(args) != 0 && args[0] == legacyHyperQubePrefix
+}
 
-	// HYPERQUBE Z UNIFORM 60
-	args := strings.Split(config.ExtraData, " ")
-	if args[0] == "HYPERQUBE" {
-		types.ZnnTokenStandard = config.TokenConfig.Tokens[0].TokenStandard
-		types.QsrTokenStandard = config.TokenConfig.Tokens[1].TokenStandard
-		constants.ConsensusConfig.CountingZTS = types.ZnnTokenStandard
-		// constants.Decimals is a const, hyperqubes should set their z and q tokens to use same Decimals as mainnet for now
+// parseLegacyHyperQube converts the legacy ExtraData form into a HyperQubeConfig.
+// The first two tokens of TokenConfig play the role of ZNN and QSR.
+func (g *GenesisConfig) parseLegacyHyperQube() (*HyperQubeConfig, error) {
+	args := strings.Fields(g.ExtraData)
+	if len(args) != 4 {
+		return nil, genesisErrorf("ExtraData", "expected `%v <name> <algorithm> <block-time>` but got %v fields in %q", legacyHyperQubePrefix, len(args), g.ExtraData)
+	}
+	if g.TokenConfig == nil || len(g.TokenConfig.Tokens) < 2 {
+		return nil, genesisErrorf("ExtraData", "the legacy %v form requires at least 2 tokens in TokenConfig.Tokens", legacyHyperQubePrefix)
+	}
+	for index, token := range g.TokenConfig.Tokens[:2] {
+		if token == nil {
+			return nil, genesisErrorf(fmt.Sprintf("TokenConfig.Tokens[%v]", index), "nil token")
+		}
+	}
+	blockTime, err := strconv.ParseInt(args[3], 10, 64)
+	if err != nil {
+		return nil, genesisErrorf("ExtraData", "invalid block time %q; reason %v", args[3], err)
+	}
+
+	return &HyperQubeConfig{
+		ZnnTokenStandard:  g.TokenConfig.Tokens[0].TokenStandard,
+		QsrTokenStandard:  g.TokenConfig.Tokens[1].TokenStandard,
+		ElectionAlgorithm: args[2],
+		BlockTime:         blockTime,
+	}, nil
+}
 
-		if args[2] == "UNIFORM" {
-			constants.ConsensusConfig.Algorithm = constants.UNIFORM
+// GetHyperQubeConfig returns the HyperQube section of the genesis, falling back to the legacy ExtraData form.
+// Returns nil, nil if the genesis doesn't describe a HyperQube.
+func (g *GenesisConfig) GetHyperQubeConfig() (*HyperQubeConfig, error) {
+	if g.HyperQube != nil {
+		if g.isLegacyHyperQube() {
+			return nil, genesisErrorf("HyperQube", "can't be used together with the legacy `%v` ExtraData", legacyHyperQubePrefix)
 		}
+		return g.HyperQube, nil
+	}
+	if g.isLegacyHyperQube() {
+		return g.parseLegacyHyperQube()
+	}
+	return nil, nil
+}
+
+// znnTokenStandard returns the token which plays the role of ZNN in this genesis
+func (g *GenesisConfig) znnTokenStandard() types.ZenonTokenStandard {
+	if hq, err := g.GetHyperQubeConfig(); err == nil && hq != nil {
+		return hq.ZnnTokenStandard
+	}
+	return types.ZnnTokenStandard
+}
+
+// qsrTokenStandard returns the token which plays the role of QSR in this genesis
+func (g *GenesisConfig) qsrTokenStandard() types.ZenonTokenStandard {
+	if hq, err := g.GetHyperQubeConfig(); err == nil && hq != nil {
+		return hq.QsrTokenStandard
+	}
+	return types.QsrTokenStandard
+}
+
+// momentumData returns the data of the genesis momentum.
+// Typed sections are appended to ExtraData so peers with different settings compute different genesis hashes.
+func (g *GenesisConfig) momentumData() []byte {
+	data := []byte(g.ExtraData)
+	if g.HyperQube != nil {
+		section, err := json.Marshal(g.HyperQube)
+		common.DealWithErr(err)
+		data = append(data, '\n')
+		data = append(data, section...)
+	}
+	if g.ContractParameters != nil {
+		section, err := json.Marshal(g.ContractParameters)
+		common.DealWithErr(err)
+		data = append(data, '\n')
+		data = append(data, section...)
+	}
+	if g.EmbeddedContracts != nil {
+		section, err := json.Marshal(g.EmbeddedContracts)
+		common.DealWithErr(err)
+		data = append(data, '\n')
+		data = append(data, section...)
+	}
+	if g.RewardSchedule != nil {
+		section, err := json.Marshal(g.RewardSchedule)
+		common.DealWithErr(err)
+		data = append(data, '\n')
+		data = append(data, section...)
+	}
+	return data
+}
 
-		// constants.ConsensusConfig.BlockTime and constants.MomentumsPerHour are set separately
-		// TODO error handling
-		duration, _ := strconv.Atoi(args[3])
-		constants.ConsensusConfig.BlockTime = int64(duration)
-		updateVmEmbeddedConstants(int64(duration))
+func CheckHyperQube(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateHyperQube(g, errs)
+	return errs.first()
+}
+func validateHyperQube(g *GenesisConfig, errs *genesisErrors) {
+	hq, err := g.GetHyperQubeConfig()
+	if err != nil {
+		errs.addError("HyperQube", err)
+		return
+	}
+	if hq == nil {
+		return
+	}
+
+	if hq.ZnnTokenStandard == types.ZeroTokenStandard {
+		errs.errorf("HyperQube.ZnnTokenStandard", "missing")
+	}
+	if hq.QsrTokenStandard == types.ZeroTokenStandard {
+		errs.errorf("HyperQube.QsrTokenStandard", "missing")
+	}
+	if hq.ZnnTokenStandard == hq.QsrTokenStandard {
+		errs.errorf("HyperQube.QsrTokenStandard", "must be different from HyperQube.ZnnTokenStandard %v", hq.ZnnTokenStandard)
+	}
+	if g.TokenConfig != nil {
+		for _, name := range []string{"ZnnTokenStandard", "QsrTokenStandard"} {
+			zts := hq.ZnnTokenStandard
+			if name == "QsrTokenStandard" {
+				zts = hq.QsrTokenStandard
+			}
+			if zts == types.ZeroTokenStandard {
+				continue
+			}
+			token := findToken(g, zts)
+			if token == nil {
+				errs.errorf("HyperQube."+name, "token %v is not declared in TokenConfig.Tokens", zts)
+			} else if token.Decimals > uint8(constants.TokenMaxDecimals) {
+				errs.errorf("HyperQube."+name, "token %v expected at most %v decimals but got %v", zts, constants.TokenMaxDecimals, token.Decimals)
+			}
+		}
 	}
 
+	if _, err := consensus.ParseElectionAlgorithm(hq.ElectionAlgorithm); err != nil {
+		errs.errorf("HyperQube.ElectionAlgorithm", "%v", err)
+	}
+	if hq.BlockTime <= 0 || hq.BlockTime > secondsInHour || secondsInHour%hq.BlockTime != 0 {
+		errs.errorf("HyperQube.BlockTime", "expected a positive divisor of %v seconds but got %v", secondsInHour, hq.BlockTime)
+	}
+	if _, err := constants.ParseConsensusMode(hq.ConsensusMode); err != nil {
+		errs.errorf("HyperQube.ConsensusMode", "%v", err)
+	}
+	if hq.NodeCount == 0 && hq.RandCount != 0 {
+		errs.errorf("HyperQube.RandCount", "can't be set without HyperQube.NodeCount")
+	} else if hq.RandCount > hq.NodeCount {
+		errs.errorf("HyperQube.RandCount", "expected at most NodeCount %v but got %v", hq.NodeCount, hq.RandCount)
+	}
 }
 
-func updateVmEmbeddedConstants(duration int64) {
-	constants.ConsensusConfig.BlockTime = duration
+// applyHyperQubeConfig updates the chain params for HyperQube genesis configs.
+// Must be called only after CheckGenesis succeeds.
+func applyHyperQubeConfig(config *GenesisConfig, params *constants.ChainParams) {
+	hq, err := config.GetHyperQubeConfig()
+	common.DealWithErr(err)
+	if hq == nil {
+		return
+	}
+
+	params.ZnnTokenStandard = hq.ZnnTokenStandard
+	params.QsrTokenStandard = hq.QsrTokenStandard
+	params.Consensus.CountingZTS = hq.ZnnTokenStandard
 
-	constants.MomentumsPerHour = 3600 / duration
+	algorithm, err := consensus.ParseElectionAlgorithm(hq.ElectionAlgorithm)
+	common.DealWithErr(err)
+	params.Consensus.Algorithm = algorithm
+	mode, err := constants.ParseConsensusMode(hq.ConsensusMode)
+	common.DealWithErr(err)
+	params.Consensus.Mode = mode
+	if hq.NodeCount != 0 {
+		params.Consensus.NodeCount = hq.NodeCount
+		params.Consensus.RandCount = hq.RandCount
+	}
 
-	constants.MomentumsPerEpoch = constants.MomentumsPerHour * 24
-	constants.UpdateMinNumMomentums = uint64(constants.MomentumsPerHour * 5 / 6)
-	constants.FuseExpiration = uint64(constants.MomentumsPerHour * 10) // for testnet, 10 hours
+	applyBlockTime(params, hq.BlockTime)
+}
 
-	// TODO bridge constants when it is integrated with hyperqube_z
+func findToken(config *GenesisConfig, zts types.ZenonTokenStandard) *definition.TokenInfo {
+	if config.TokenConfig == nil {
+		return nil
+	}
+	for _, token := range config.TokenConfig.Tokens {
+		if token != nil && token.TokenStandard == zts {
+			return token
+		}
+	}
+	return nil
+}
 
-	constants.NetworkZnnRewardConfig = []int64{
-		10 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		6 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		5 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		7 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		5 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		4 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		7 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		4 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		3 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		7 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
-		3 * constants.MomentumsPerEpoch / 6 * constants.Decimals,
+// applyTokenDecimals scales the ZNN and QSR amounts of the chain params to the decimals
+// of the tokens declared in TokenConfig. Must be called before applyContractParameters,
+// whose amounts are already expressed in base units.
+func applyTokenDecimals(config *GenesisConfig, params *constants.ChainParams) {
+	znnDecimals, qsrDecimals := params.ZnnDecimals, params.QsrDecimals
+	if token := findToken(config, params.ZnnTokenStandard); token != nil {
+		znnDecimals = token.Decimals
 	}
+	if token := findToken(config, params.QsrTokenStandard); token != nil {
+		qsrDecimals = token.Decimals
+	}
+	params.SetTokenDecimals(znnDecimals, qsrDecimals)
+}
+
+func applyBlockTime(params *constants.ChainParams, duration int64) {
+	params.Consensus.BlockTime = duration
+
+	params.MomentumsPerHour = secondsInHour / duration
 
+	params.MomentumsPerEpoch = params.MomentumsPerHour * 24
+	params.UpdateMinNumMomentums = uint64(params.MomentumsPerHour * 5 / 6)
+	params.FuseExpiration = uint64(params.MomentumsPerHour * 10) // for testnet, 10 hours
+
+	// bridge and liquidity security windows keep the same wall-clock duration as on mainnet
+	params.MinUnhaltDurationInMomentums = uint64(6 * params.MomentumsPerHour)
+	params.MinAdministratorDelay = uint64(2 * params.MomentumsPerEpoch)
+	params.MinSoftDelay = uint64(params.MomentumsPerEpoch)
+
+	params.NetworkZnnRewardConfig = []*big.Int{
+		big.NewInt(10 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(6 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(5 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(7 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(5 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(4 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(7 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(4 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(3 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(7 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+		big.NewInt(3 * params.MomentumsPerEpoch / 6 * constants.Decimals),
+	}
 }
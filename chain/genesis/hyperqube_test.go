@@ -0,0 +1,252 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+var (
+	hyperQubeZts1 = types.ParseZTSPanic("zts1znnxxxxxxxxxxxxx9z4ulx")
+	hyperQubeZts2 = types.ParseZTSPanic("zts1qsrxxxxxxxxxxxxxmrhjll")
+
+	// legacyHyperQubeGenesisJsonStr is a legacy hyperqube with its own Z and Q tokens
+	legacyHyperQubeGenesisJsonStr = []byte(`
+{
+	"ChainIdentifier": 321,
+	"ExtraData": "HYPERQUBE Z UNIFORM 60",
+	"GenesisTimestampSec": 1000000000,
+	"SporkAddress": "z1qqv2fnc3avjg39dcste4c5lag7l42xyykjf49w",
+	"PillarConfig": {
+		"Pillars": [],
+		"Delegations": [],
+		"LegacyEntries": []
+	},
+	"TokenConfig": {
+		"Tokens": [
+			{
+				"decimals": 8,
+				"isBurnable": true,
+				"isMintable": true,
+				"isUtility": true,
+				"maxSupply": 9007199254740991,
+				"owner": "z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0",
+				"tokenDomain": "zenon.network",
+				"tokenName": "Z",
+				"tokenStandard": "zts1fvxu2cj089dy9x85k3mxzk",
+				"tokenSymbol": "Z",
+				"totalSupply": 100000000000
+			},
+			{
+				"decimals": 8,
+				"isBurnable": true,
+				"isMintable": true,
+				"isUtility": true,
+				"maxSupply": 9007199254740991,
+				"owner": "z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0",
+				"tokenDomain": "zenon.network",
+				"tokenName": "Q",
+				"tokenStandard": "zts100xnnux7xll2wc2x3f43e9",
+				"tokenSymbol": "Q",
+				"totalSupply": 500000000000
+			}
+		]
+	},
+	"PlasmaConfig": {
+		"Fusions": []
+	},
+	"SwapConfig": {
+		"Entries": []
+	},
+	"SporkConfig": {
+		"Sporks": []
+	},
+	"GenesisBlocks": {
+		"Blocks": [
+			{
+				"Address": "z1qqv2fnc3avjg39dcste4c5lag7l42xyykjf49w",
+				"BalanceList": {
+					"zts1fvxu2cj089dy9x85k3mxzk": 100000000000,
+					"zts100xnnux7xll2wc2x3f43e9": 500000000000
+				}
+			}
+		]
+	}
+}`)
+	// legacyHyperQubeHash is the genesis hash of legacyHyperQubeGenesisJsonStr computed before the chain params existed
+	legacyHyperQubeHash = types.HexToHashPanic("d197f90d82d1eb22d0b28aee29047894f4faadb39f1b0d9f068cadd09784b375")
+)
+
+func newHyperQubeTestConfig(t *testing.T, extraData string, hq *HyperQubeConfig) *GenesisConfig {
+	config := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(emptyGenesisJsonStr, config))
+	config.ExtraData = extraData
+	config.HyperQube = hq
+	config.TokenConfig.Tokens = append(config.TokenConfig.Tokens, embeddedGenesis.TokenConfig.Tokens...)
+	return config
+}
+
+func expectHyperQubeError(t *testing.T, config *GenesisConfig, expected string) {
+	err := CheckHyperQube(config)
+	if err == nil {
+		t.Fatalf("expected error containing %q but got nil", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q but got %q", expected, err)
+	}
+}
+
+func TestLegacyHyperQubeExtraData(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM 60", nil)
+	common.FailIfErr(t, CheckHyperQube(config))
+
+	hq, err := config.GetHyperQubeConfig()
+	common.FailIfErr(t, err)
+	common.ExpectJson(t, hq, `
+{
+	"ZnnTokenStandard": "zts1znnxxxxxxxxxxxxx9z4ulx",
+	"QsrTokenStandard": "zts1qsrxxxxxxxxxxxxxmrhjll",
+	"ElectionAlgorithm": "UNIFORM",
+	"BlockTime": 60,
+	"NodeCount": 0,
+	"RandCount": 0
+}`)
+}
+
+func TestLegacyHyperQubeInvalidExtraData(t *testing.T) {
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM", nil), "expected `HYPERQUBE <name> <algorithm> <block-time>` but got 3 fields")
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM 6O", nil), "ExtraData: invalid block time \"6O\"")
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFROM 60", nil), "HyperQube.ElectionAlgorithm: unknown election algorithm \"UNIFROM\"")
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM 0", nil), "HyperQube.BlockTime: expected a positive divisor of 3600 seconds but got 0")
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM 7", nil), "HyperQube.BlockTime: expected a positive divisor of 3600 seconds but got 7")
+}
+
+// the genesis momentum of the existing legacy hyperqubes is generated with their Z token
+func TestLegacyHyperQubeGenesisHash(t *testing.T) {
+	config := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(legacyHyperQubeGenesisJsonStr, config))
+	common.FailIfErr(t, CheckGenesis(config))
+	common.ExpectString(t, NewGenesis(config).GetGenesisMomentum().Hash.String(), legacyHyperQubeHash.String())
+}
+
+func TestHyperQubeSection(t *testing.T) {
+	valid := func() *HyperQubeConfig {
+		return &HyperQubeConfig{
+			ZnnTokenStandard:  hyperQubeZts1,
+			QsrTokenStandard:  hyperQubeZts2,
+			ElectionAlgorithm: "MAINNET",
+			BlockTime:         20,
+			NodeCount:         10,
+			RandCount:         5,
+		}
+	}
+	common.FailIfErr(t, CheckHyperQube(newHyperQubeTestConfig(t, "", valid())))
+
+	hq := valid()
+	hq.QsrTokenStandard = hq.ZnnTokenStandard
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "", hq), "HyperQube.QsrTokenStandard: must be different")
+
+	hq = valid()
+	hq.ZnnTokenStandard = types.ParseZTSPanic("zts1tvx7a6qqaqlkedxzxrvwvd")
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "", hq), "HyperQube.ZnnTokenStandard: token zts1tvx7a6qqaqlkedxzxrvwvd is not declared")
+
+	hq = valid()
+	hq.RandCount = 11
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "", hq), "HyperQube.RandCount: expected at most NodeCount 10 but got 11")
+
+	expectHyperQubeError(t, newHyperQubeTestConfig(t, "HYPERQUBE Z UNIFORM 60", valid()), "HyperQube: can't be used together with the legacy")
+}
+
+func TestHyperQubeSectionChangesGenesisHash(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	config.TokenConfig.Tokens = nil
+	common.ExpectString(t, NewGenesis(config).GetGenesisMomentum().Hash.String(), emptyHash.String())
+
+	config.HyperQube = &HyperQubeConfig{
+		ZnnTokenStandard:  hyperQubeZts1,
+		QsrTokenStandard:  hyperQubeZts2,
+		ElectionAlgorithm: "UNIFORM",
+		BlockTime:         60,
+	}
+	if NewGenesis(config).GetGenesisMomentum().Hash == emptyHash {
+		t.Fatalf("expected the HyperQube section to change the genesis hash")
+	}
+}
+
+func TestHyperQubeChainParams(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", &HyperQubeConfig{
+		ZnnTokenStandard:  hyperQubeZts2,
+		QsrTokenStandard:  hyperQubeZts1,
+		ElectionAlgorithm: "UNIFORM",
+		BlockTime:         60,
+		NodeCount:         10,
+		RandCount:         5,
+	})
+	config.ContractParameters = &ContractParametersConfig{PillarStakeAmount: big.NewInt(1)}
+	params := NewGenesis(config).GetChainParams()
+	common.ExpectString(t, params.ZnnTokenStandard.String(), hyperQubeZts2.String())
+	common.ExpectString(t, params.Consensus.CountingZTS.String(), hyperQubeZts2.String())
+	common.ExpectUint64(t, uint64(params.Consensus.BlockTime), 60)
+	common.ExpectUint64(t, uint64(params.Consensus.NodeCount), 10)
+	common.ExpectUint64(t, uint64(params.MomentumsPerHour), 60)
+	common.ExpectUint64(t, params.UpdateMinNumMomentums, 50)
+	common.ExpectUint64(t, params.MinUnhaltDurationInMomentums, 6*60)
+	common.ExpectUint64(t, params.MinAdministratorDelay, 2*24*60)
+	common.ExpectUint64(t, params.MinSoftDelay, 24*60)
+	common.ExpectString(t, params.PillarStakeAmount.String(), "1")
+
+	// a second chain in the same process keeps the default params
+	defaults := NewGenesis(newHyperQubeTestConfig(t, "", nil)).GetChainParams()
+	common.ExpectString(t, defaults.ZnnTokenStandard.String(), types.ZnnTokenStandard.String())
+	common.ExpectUint64(t, uint64(defaults.Consensus.BlockTime), uint64(constants.ConsensusConfig.BlockTime))
+	common.ExpectUint64(t, uint64(defaults.MomentumsPerHour), uint64(constants.MomentumsPerHour))
+	common.ExpectString(t, defaults.PillarStakeAmount.String(), constants.PillarStakeAmount.String())
+}
+
+func TestHyperQubeTokenDecimals(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", &HyperQubeConfig{
+		ZnnTokenStandard:  hyperQubeZts1,
+		QsrTokenStandard:  hyperQubeZts2,
+		ElectionAlgorithm: "UNIFORM",
+		BlockTime:         10,
+	})
+	// copy the tokens, they are shared with the embedded genesis
+	for i, token := range config.TokenConfig.Tokens {
+		copied := *token
+		switch token.TokenStandard {
+		case hyperQubeZts1:
+			copied.Decimals = 6
+		case hyperQubeZts2:
+			copied.Decimals = 18
+		}
+		config.TokenConfig.Tokens[i] = &copied
+	}
+	common.FailIfErr(t, CheckHyperQube(config))
+
+	params := NewGenesis(config).GetChainParams()
+	common.ExpectUint64(t, uint64(params.ZnnDecimals), 6)
+	common.ExpectUint64(t, uint64(params.QsrDecimals), 18)
+	common.ExpectString(t, params.PillarStakeAmount.String(), "15000000000")
+	common.ExpectString(t, params.StakeMinAmount.String(), "1000000")
+	common.ExpectString(t, params.SentinelQsrDepositAmount.String(), "50000000000000000000000")
+	common.ExpectString(t, params.FuseMinAmount.String(), "10000000000000000000")
+	common.ExpectString(t, params.CostPerFusionUnit.String(), "1000000000000000000")
+	common.ExpectString(t, params.NetworkZnnRewardPerEpoch(0).String(), "14400000000")
+	common.ExpectString(t, params.NetworkQsrRewardPerEpoch(0).String(), "20000000000000000000000")
+
+	// 18 decimals rewards don't fit in an int64
+	_, qsr := params.SentinelRewardForEpoch(0)
+	common.ExpectString(t, qsr.String(), "5000000000000000000000")
+
+	for _, token := range config.TokenConfig.Tokens {
+		if token.TokenStandard == hyperQubeZts1 {
+			token.Decimals = 19
+		}
+	}
+	expectHyperQubeError(t, config, "HyperQube.ZnnTokenStandard: token zts1znnxxxxxxxxxxxxx9z4ulx expected at most 18 decimals but got 19")
+}
@@ -7,9 +7,10 @@ import (
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/vm"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
-func newGenesisMomentum(genesisConfig *GenesisConfig, pool chain.AccountPool) *nom.MomentumTransaction {
+func newGenesisMomentum(genesisConfig *GenesisConfig, pool chain.AccountPool, chainParams *constants.ChainParams) *nom.MomentumTransaction {
 	timestamp := time.Unix(genesisConfig.GenesisTimestampSec, 0)
 	blocks := pool.GetAllUncommittedAccountBlocks()
 
@@ -20,11 +21,11 @@ func newGenesisMomentum(genesisConfig *GenesisConfig, pool chain.AccountPool) *n
 		ChainIdentifier: genesisConfig.ChainIdentifier,
 		Height:          1, // height
 		TimestampUnix:   uint64(timestamp.Unix()),
-		Data:            []byte(genesisConfig.ExtraData),
+		Data:            genesisConfig.momentumData(),
 		Content:         nom.NewMomentumContent(blocks),
 	}
 	m.EnsureCache()
-	transaction, err := supervisor.GenerateGenesisMomentum(m, pool)
+	transaction, err := supervisor.GenerateGenesisMomentum(m, pool, chainParams)
 	common.DealWithErr(err)
 
 	return transaction
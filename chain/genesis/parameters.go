@@ -0,0 +1,142 @@
+package genesis
+
+import (
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+// ContractParametersConfig overrides the economic parameters of the embedded contracts.
+// Amounts are expressed in base units and times in seconds. Nil fields keep the mainnet values.
+type ContractParametersConfig struct {
+	// Pillar
+	PillarStakeAmount            *big.Int `json:",omitempty"`
+	PillarQsrStakeBaseAmount     *big.Int `json:",omitempty"`
+	PillarQsrStakeIncreaseAmount *big.Int `json:",omitempty"`
+	PillarEpochLockTime          *int64   `json:",omitempty"`
+	PillarEpochRevokeTime        *int64   `json:",omitempty"`
+
+	// Sentinel
+	SentinelZnnRegisterAmount *big.Int `json:",omitempty"`
+	SentinelQsrDepositAmount  *big.Int `json:",omitempty"`
+	SentinelLockTimeWindow    *int64   `json:",omitempty"`
+	SentinelRevokeTimeWindow  *int64   `json:",omitempty"`
+
+	// Stake, StakeTimeUnitSec also updates the min (1 unit) and max (12 units) stake durations
+	StakeTimeUnitSec *int64   `json:",omitempty"`
+	StakeMinAmount   *big.Int `json:",omitempty"`
+
+	// Plasma
+	FuseMinAmount *big.Int `json:",omitempty"`
+
+	// Token
+	TokenIssueAmount *big.Int `json:",omitempty"`
+
+	// Accelerator
+	ProjectCreationAmount          *big.Int `json:",omitempty"`
+	ProjectZnnMaximumFunds         *big.Int `json:",omitempty"`
+	ProjectQsrMaximumFunds         *big.Int `json:",omitempty"`
+	VoteAcceptanceThreshold        *uint32  `json:",omitempty"`
+	AcceleratorProjectVotingPeriod *int64   `json:",omitempty"`
+}
+
+type amountParameter struct {
+	name  string
+	value *big.Int
+	param **big.Int
+	// allowZero is set for parameters which can be disabled, like the pillar QSR cost increase
+	allowZero bool
+}
+
+type timeParameter struct {
+	name  string
+	value *int64
+	param *int64
+}
+
+func (p *ContractParametersConfig) amounts(params *constants.ChainParams) []amountParameter {
+	return []amountParameter{
+		{"PillarStakeAmount", p.PillarStakeAmount, &params.PillarStakeAmount, false},
+		{"PillarQsrStakeBaseAmount", p.PillarQsrStakeBaseAmount, &params.PillarQsrStakeBaseAmount, false},
+		{"PillarQsrStakeIncreaseAmount", p.PillarQsrStakeIncreaseAmount, &params.PillarQsrStakeIncreaseAmount, true},
+		{"SentinelZnnRegisterAmount", p.SentinelZnnRegisterAmount, &params.SentinelZnnRegisterAmount, false},
+		{"SentinelQsrDepositAmount", p.SentinelQsrDepositAmount, &params.SentinelQsrDepositAmount, false},
+		{"StakeMinAmount", p.StakeMinAmount, &params.StakeMinAmount, false},
+		{"FuseMinAmount", p.FuseMinAmount, &params.FuseMinAmount, false},
+		{"TokenIssueAmount", p.TokenIssueAmount, &params.TokenIssueAmount, false},
+		{"ProjectCreationAmount", p.ProjectCreationAmount, &params.ProjectCreationAmount, false},
+		{"ProjectZnnMaximumFunds", p.ProjectZnnMaximumFunds, &params.ProjectZnnMaximumFunds, false},
+		{"ProjectQsrMaximumFunds", p.ProjectQsrMaximumFunds, &params.ProjectQsrMaximumFunds, false},
+	}
+}
+
+func (p *ContractParametersConfig) times(params *constants.ChainParams) []timeParameter {
+	return []timeParameter{
+		{"PillarEpochLockTime", p.PillarEpochLockTime, &params.PillarEpochLockTime},
+		{"PillarEpochRevokeTime", p.PillarEpochRevokeTime, &params.PillarEpochRevokeTime},
+		{"SentinelLockTimeWindow", p.SentinelLockTimeWindow, &params.SentinelLockTimeWindow},
+		{"SentinelRevokeTimeWindow", p.SentinelRevokeTimeWindow, &params.SentinelRevokeTimeWindow},
+		{"StakeTimeUnitSec", p.StakeTimeUnitSec, &params.StakeTimeUnitSec},
+		{"AcceleratorProjectVotingPeriod", p.AcceleratorProjectVotingPeriod, &params.AcceleratorProjectVotingPeriod},
+	}
+}
+
+func CheckContractParameters(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateContractParameters(g, errs)
+	return errs.first()
+}
+func validateContractParameters(g *GenesisConfig, errs *genesisErrors) {
+	p := g.ContractParameters
+	if p == nil {
+		return
+	}
+
+	// the targets are only needed by applyContractParameters
+	params := new(constants.ChainParams)
+	for _, amount := range p.amounts(params) {
+		if amount.value == nil {
+			continue
+		}
+		path := "ContractParameters." + amount.name
+		if amount.value.Sign() < 0 || (amount.value.Sign() == 0 && !amount.allowZero) {
+			errs.errorf(path, "expected a positive amount but got %v", amount.value)
+		} else if amount.value.Cmp(constants.TokenMaxSupplyBig) > 0 {
+			errs.errorf(path, "expected at most %v but got %v", constants.TokenMaxSupplyBig, amount.value)
+		}
+	}
+	for _, time := range p.times(params) {
+		if time.value != nil && *time.value <= 0 {
+			errs.errorf("ContractParameters."+time.name, "expected a positive number of seconds but got %v", *time.value)
+		}
+	}
+	if p.VoteAcceptanceThreshold != nil && (*p.VoteAcceptanceThreshold == 0 || *p.VoteAcceptanceThreshold > 100) {
+		errs.errorf("ContractParameters.VoteAcceptanceThreshold", "expected a percentage between 1 and 100 but got %v", *p.VoteAcceptanceThreshold)
+	}
+}
+
+// applyContractParameters overrides the chain params with the ContractParameters section.
+// Must be called only after CheckGenesis succeeds.
+func applyContractParameters(config *GenesisConfig, params *constants.ChainParams) {
+	p := config.ContractParameters
+	if p == nil {
+		return
+	}
+
+	for _, amount := range p.amounts(params) {
+		if amount.value != nil {
+			*amount.param = new(big.Int).Set(amount.value)
+		}
+	}
+	for _, time := range p.times(params) {
+		if time.value != nil {
+			*time.param = *time.value
+		}
+	}
+	if p.VoteAcceptanceThreshold != nil {
+		params.VoteAcceptanceThreshold = *p.VoteAcceptanceThreshold
+	}
+
+	params.StakeTimeMinSec = params.StakeTimeUnitSec * 1
+	params.StakeTimeMaxSec = params.StakeTimeUnitSec * 12
+}
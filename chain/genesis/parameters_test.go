@@ -0,0 +1,79 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+)
+
+func expectContractParametersError(t *testing.T, config *GenesisConfig, expected string) {
+	err := CheckContractParameters(config)
+	if err == nil {
+		t.Fatalf("expected error containing %q but got nil", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q but got %q", expected, err)
+	}
+}
+
+func TestContractParametersFromJson(t *testing.T) {
+	config := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal([]byte(`
+{
+	"ContractParameters": {
+		"PillarStakeAmount": 100000000,
+		"PillarQsrStakeIncreaseAmount": 0,
+		"StakeTimeUnitSec": 3600,
+		"VoteAcceptanceThreshold": 50
+	}
+}`), config))
+	common.FailIfErr(t, CheckContractParameters(config))
+	common.ExpectJson(t, config.ContractParameters, `
+{
+	"PillarStakeAmount": 100000000,
+	"PillarQsrStakeIncreaseAmount": 0,
+	"StakeTimeUnitSec": 3600,
+	"VoteAcceptanceThreshold": 50
+}`)
+}
+
+func TestContractParametersInvalid(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+
+	config.ContractParameters = &ContractParametersConfig{PillarStakeAmount: big.NewInt(0)}
+	expectContractParametersError(t, config, "ContractParameters.PillarStakeAmount: expected a positive amount but got 0")
+
+	config.ContractParameters = &ContractParametersConfig{FuseMinAmount: big.NewInt(-1)}
+	expectContractParametersError(t, config, "ContractParameters.FuseMinAmount: expected a positive amount but got -1")
+
+	config.ContractParameters = &ContractParametersConfig{TokenIssueAmount: new(big.Int).Add(common.BigP255m1, common.Big1)}
+	expectContractParametersError(t, config, "ContractParameters.TokenIssueAmount: expected at most")
+
+	lockTime := int64(0)
+	config.ContractParameters = &ContractParametersConfig{SentinelLockTimeWindow: &lockTime}
+	expectContractParametersError(t, config, "ContractParameters.SentinelLockTimeWindow: expected a positive number of seconds but got 0")
+
+	threshold := uint32(101)
+	config.ContractParameters = &ContractParametersConfig{VoteAcceptanceThreshold: &threshold}
+	expectContractParametersError(t, config, "ContractParameters.VoteAcceptanceThreshold: expected a percentage between 1 and 100 but got 101")
+}
+
+func TestContractParametersChangeGenesisHash(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	config.TokenConfig.Tokens = nil
+	common.ExpectString(t, NewGenesis(config).GetGenesisMomentum().Hash.String(), emptyHash.String())
+
+	config.ContractParameters = &ContractParametersConfig{PillarStakeAmount: big.NewInt(1)}
+	first := NewGenesis(config).GetGenesisMomentum().Hash
+	if first == emptyHash {
+		t.Fatalf("expected the ContractParameters section to change the genesis hash")
+	}
+
+	config.ContractParameters = &ContractParametersConfig{PillarStakeAmount: big.NewInt(2)}
+	if NewGenesis(config).GetGenesisMomentum().Hash == first {
+		t.Fatalf("expected different ContractParameters to produce different genesis hashes")
+	}
+}
@@ -0,0 +1,128 @@
+package genesis
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+// RewardScheduleConfig overrides the emission curve of the network rewards.
+// Amounts are expressed in base units per epoch. Each entry of ZnnPerEpoch and QsrPerEpoch
+// is emitted for TickDurationInEpochs epochs and the last entry is emitted forever, so it must be zero.
+type RewardScheduleConfig struct {
+	TickDurationInEpochs uint64
+	ZnnPerEpoch          []*big.Int
+	QsrPerEpoch          []*big.Int
+
+	// ZNN split between pillars, delegators, sentinels and liquidity, must add up to 100
+	DelegationZnnPercentage        int64
+	MomentumProducingZnnPercentage int64
+	SentinelZnnPercentage          int64
+	LiquidityZnnPercentage         int64
+
+	// QSR split between stakers, sentinels and liquidity, must add up to 100
+	StakingQsrPercentage   int64
+	SentinelQsrPercentage  int64
+	LiquidityQsrPercentage int64
+}
+
+// ticksEmission returns the amount emitted by all the ticks
+func ticksEmission(ticks []*big.Int, duration uint64) *big.Int {
+	total := new(big.Int)
+	for _, amount := range ticks {
+		total.Add(total, amount)
+	}
+	return total.Mul(total, new(big.Int).SetUint64(duration))
+}
+
+func validateRewardTicks(g *GenesisConfig, errs *genesisErrors, name string, ticks []*big.Int, duration uint64, zts types.ZenonTokenStandard) {
+	path := "RewardSchedule." + name
+	if len(ticks) == 0 {
+		errs.errorf(path, "expected at least one tick")
+		return
+	}
+	for i, amount := range ticks {
+		if amount == nil || amount.Sign() < 0 {
+			errs.errorf(fmt.Sprintf("%v[%v]", path, i), "expected a non-negative amount but got %v", amount)
+			return
+		}
+	}
+	// the emission of a non-zero last tick is unbounded
+	if last := ticks[len(ticks)-1]; last.Sign() != 0 {
+		errs.errorf(fmt.Sprintf("%v[%v]", path, len(ticks)-1), "expected the last tick, which is emitted forever, to be zero but got %v", last)
+		return
+	}
+
+	total := ticksEmission(ticks, duration)
+	supply := big.NewInt(0)
+	if token := findToken(g, zts); token != nil && token.TotalSupply != nil {
+		supply = token.TotalSupply
+	}
+	if new(big.Int).Add(total, supply).Cmp(constants.TokenMaxSupplyBig) > 0 {
+		errs.errorf(path, "emission of %v for %v ticks plus the genesis supply %v exceeds the max supply %v", total, len(ticks), supply, constants.TokenMaxSupplyBig)
+	}
+}
+
+func validateRewardPercentages(errs *genesisErrors, name string, percentages ...int64) {
+	sum := int64(0)
+	for _, percentage := range percentages {
+		if percentage < 0 || percentage > 100 {
+			errs.errorf("RewardSchedule", "expected %v percentages between 0 and 100 but got %v", name, percentage)
+			return
+		}
+		sum += percentage
+	}
+	if sum != 100 {
+		errs.errorf("RewardSchedule", "expected %v percentages to add up to 100 but got %v", name, sum)
+	}
+}
+
+func CheckRewardSchedule(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateRewardSchedule(g, errs)
+	return errs.first()
+}
+func validateRewardSchedule(g *GenesisConfig, errs *genesisErrors) {
+	rs := g.RewardSchedule
+	if rs == nil {
+		return
+	}
+
+	if rs.TickDurationInEpochs == 0 {
+		errs.errorf("RewardSchedule.TickDurationInEpochs", "expected a positive number of epochs")
+	} else {
+		validateRewardTicks(g, errs, "ZnnPerEpoch", rs.ZnnPerEpoch, rs.TickDurationInEpochs, g.znnTokenStandard())
+		validateRewardTicks(g, errs, "QsrPerEpoch", rs.QsrPerEpoch, rs.TickDurationInEpochs, g.qsrTokenStandard())
+	}
+	validateRewardPercentages(errs, "Znn", rs.DelegationZnnPercentage, rs.MomentumProducingZnnPercentage, rs.SentinelZnnPercentage, rs.LiquidityZnnPercentage)
+	validateRewardPercentages(errs, "Qsr", rs.StakingQsrPercentage, rs.SentinelQsrPercentage, rs.LiquidityQsrPercentage)
+}
+
+// applyRewardSchedule overrides the chain params with the RewardSchedule section.
+// Must be called only after CheckGenesis succeeds.
+func applyRewardSchedule(config *GenesisConfig, params *constants.ChainParams) {
+	rs := config.RewardSchedule
+	if rs == nil {
+		return
+	}
+
+	params.RewardTickDurationInEpochs = rs.TickDurationInEpochs
+	params.NetworkZnnRewardConfig = make([]*big.Int, len(rs.ZnnPerEpoch))
+	for i, amount := range rs.ZnnPerEpoch {
+		params.NetworkZnnRewardConfig[i] = new(big.Int).Set(amount)
+	}
+	params.NetworkQsrRewardConfig = make([]*big.Int, len(rs.QsrPerEpoch))
+	for i, amount := range rs.QsrPerEpoch {
+		params.NetworkQsrRewardConfig[i] = new(big.Int).Set(amount)
+	}
+
+	params.DelegationZnnRewardPercentage = rs.DelegationZnnPercentage
+	params.MomentumProducingZnnRewardPercentage = rs.MomentumProducingZnnPercentage
+	params.SentinelZnnRewardPercentage = rs.SentinelZnnPercentage
+	params.LiquidityZnnRewardPercentage = rs.LiquidityZnnPercentage
+	params.StakingQsrRewardPercentage = rs.StakingQsrPercentage
+	params.SentinelQsrRewardPercentage = rs.SentinelQsrPercentage
+	params.LiquidityQsrRewardPercentage = rs.LiquidityQsrPercentage
+}
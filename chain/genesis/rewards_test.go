@@ -0,0 +1,101 @@
+package genesis
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+func newRewardScheduleTestConfig() *RewardScheduleConfig {
+	return &RewardScheduleConfig{
+		TickDurationInEpochs:           10,
+		ZnnPerEpoch:                    []*big.Int{big.NewInt(1000), big.NewInt(500), big.NewInt(0)},
+		QsrPerEpoch:                    []*big.Int{big.NewInt(2000), big.NewInt(0)},
+		DelegationZnnPercentage:        20,
+		MomentumProducingZnnPercentage: 50,
+		SentinelZnnPercentage:          10,
+		LiquidityZnnPercentage:         20,
+		StakingQsrPercentage:           60,
+		SentinelQsrPercentage:          20,
+		LiquidityQsrPercentage:         20,
+	}
+}
+
+func expectRewardScheduleError(t *testing.T, config *GenesisConfig, expected string) {
+	err := CheckRewardSchedule(config)
+	if err == nil {
+		t.Fatalf("expected error containing %q but got nil", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q but got %q", expected, err)
+	}
+}
+
+func TestRewardSchedule(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	common.FailIfErr(t, CheckRewardSchedule(config))
+
+	params := NewGenesis(config).GetChainParams()
+	common.ExpectString(t, params.NetworkZnnRewardPerEpoch(9).String(), "1000")
+	common.ExpectString(t, params.NetworkZnnRewardPerEpoch(10).String(), "500")
+	common.ExpectString(t, params.NetworkZnnRewardPerEpoch(19).String(), "500")
+	common.ExpectString(t, params.NetworkZnnRewardPerEpoch(1000).String(), "0")
+	common.ExpectString(t, params.StakeQsrRewardPerEpoch(0).String(), "1200")
+	znn, qsr := params.SentinelRewardForEpoch(0)
+	common.ExpectString(t, znn.String(), "100")
+	common.ExpectString(t, qsr.String(), "400")
+
+	znn, qsr = params.CumulatedNetworkRewards(0)
+	common.ExpectString(t, znn.String(), "1000")
+	common.ExpectString(t, qsr.String(), "2000")
+	znn, qsr = params.CumulatedNetworkRewards(24)
+	common.ExpectString(t, znn.String(), "15000")
+	common.ExpectString(t, qsr.String(), "20000")
+}
+
+func TestRewardScheduleInvalid(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.TickDurationInEpochs = 0
+	expectRewardScheduleError(t, config, "RewardSchedule.TickDurationInEpochs: expected a positive number of epochs")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.QsrPerEpoch = nil
+	expectRewardScheduleError(t, config, "RewardSchedule.QsrPerEpoch: expected at least one tick")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.ZnnPerEpoch[1] = big.NewInt(-1)
+	expectRewardScheduleError(t, config, "RewardSchedule.ZnnPerEpoch[1]: expected a non-negative amount but got -1")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.QsrPerEpoch[1] = big.NewInt(1)
+	expectRewardScheduleError(t, config, "RewardSchedule.QsrPerEpoch[1]: expected the last tick, which is emitted forever, to be zero but got 1")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.ZnnPerEpoch[0] = new(big.Int).Div(constants.TokenMaxSupplyBig, big.NewInt(5))
+	expectRewardScheduleError(t, config, "RewardSchedule.ZnnPerEpoch: emission of")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.SentinelZnnPercentage = 11
+	expectRewardScheduleError(t, config, "RewardSchedule: expected Znn percentages to add up to 100 but got 101")
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.StakingQsrPercentage = -20
+	expectRewardScheduleError(t, config, "RewardSchedule: expected Qsr percentages between 0 and 100 but got -20")
+}
+
+func TestRewardScheduleChangesGenesisHash(t *testing.T) {
+	config := newHyperQubeTestConfig(t, "", nil)
+	config.TokenConfig.Tokens = nil
+	common.ExpectString(t, NewGenesis(config).GetGenesisMomentum().Hash.String(), emptyHash.String())
+
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	if NewGenesis(config).GetGenesisMomentum().Hash == emptyHash {
+		t.Fatalf("expected the RewardSchedule section to change the genesis hash")
+	}
+}
@@ -1,7 +1,9 @@
 package genesis
 
 import (
+	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/pkg/errors"
 
@@ -9,118 +11,192 @@ import (
 	"github.com/zenon-network/go-zenon/common/types"
 )
 
-func checkAccountBalance(g *GenesisConfig, addr types.Address, required map[types.ZenonTokenStandard]*big.Int) error {
+// sortedTokens returns the tokens of a balance list in a deterministic order
+func sortedTokens(balances map[types.ZenonTokenStandard]*big.Int) []types.ZenonTokenStandard {
+	tokens := make([]types.ZenonTokenStandard, 0, len(balances))
+	for zts := range balances {
+		tokens = append(tokens, zts)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].String() < tokens[j].String()
+	})
+	return tokens
+}
+
+func checkAccountBalance(g *GenesisConfig, errs *genesisErrors, addr types.Address, required map[types.ZenonTokenStandard]*big.Int) {
+	if g.GenesisBlocks == nil {
+		return
+	}
 	// Check account balance for enough qsr
-	for _, block := range g.GenesisBlocks.Blocks {
-		if block.Address != addr {
+	for index, block := range g.GenesisBlocks.Blocks {
+		if block == nil || block.Address != addr {
 			continue
 		}
 
-		for zts, amount := range block.BalanceList {
+		path := fmt.Sprintf("GenesisBlocks.Blocks[%v].BalanceList", index)
+		for _, zts := range sortedTokens(block.BalanceList) {
+			amount := block.BalanceList[zts]
 			requiredAmount, ok := required[zts]
 			if !ok {
-				return errors.Errorf("invalid balance for %v Extra token %v", addr, zts)
-			} else {
-				if requiredAmount.Cmp(amount) != 0 {
-					return errors.Errorf("invalid balance for %v Expected %v %v but got %v", addr, requiredAmount, zts, amount)
-				}
+				errs.errorf(path, "invalid balance for %v Extra token %v", addr, zts)
+			} else if requiredAmount.Cmp(amount) != 0 {
+				errs.errorf(path+"."+zts.String(), "invalid balance for %v Expected %v %v but got %v", addr, requiredAmount, zts, amount)
 			}
 		}
 
-		for token := range required {
+		for _, token := range sortedTokens(required) {
 			_, ok := block.BalanceList[token]
 			if !ok && required[token].Cmp(common.Big0) != 0 {
-				return errors.Errorf("invalid balance for %v Expected token %v to be present", addr, token)
+				errs.errorf(path, "invalid balance for %v Expected token %v to be present", addr, token)
 			}
 		}
 	}
-
-	return nil
 }
 
 func CheckGenesis(g *GenesisConfig) error {
-	if err := CheckFieldsExist(g); err != nil {
-		return err
-	}
-	if err := CheckPlasmaInfo(g); err != nil {
-		return err
-	}
-	if err := CheckSwapAccount(g); err != nil {
-		return err
-	}
-	if err := CheckPillarBalance(g); err != nil {
-		return err
-	}
-	if err := CheckTokenTotalSupply(g); err != nil {
-		return err
+	if errs := ValidateGenesis(g); len(errs) != 0 {
+		return errs[0]
 	}
 	return nil
 }
 
 func CheckFieldsExist(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateFieldsExist(g, errs)
+	return errs.first()
+}
+func validateFieldsExist(g *GenesisConfig, errs *genesisErrors) {
 	if g.GenesisBlocks == nil {
-		return errors.Errorf("GenesisBlocks is nil")
+		errs.errorf("GenesisBlocks", "missing")
 	}
 	if g.TokenConfig == nil {
-		return errors.Errorf("TokenConfig is nil")
+		errs.errorf("TokenConfig", "missing")
 	}
 	if g.PillarConfig == nil {
-		return errors.Errorf("PillarConfig is nil")
+		errs.errorf("PillarConfig", "missing")
 	}
 	if g.SporkAddress == nil {
-		return errors.Errorf("SporkAddress is nil")
+		errs.errorf("SporkAddress", "missing")
 	}
 	if g.PlasmaConfig == nil {
-		return errors.Errorf("PlasmaConfig is nil")
+		errs.errorf("PlasmaConfig", "missing")
 	}
 	if g.SwapConfig == nil {
-		return errors.Errorf("SwapConfig is nil")
+		errs.errorf("SwapConfig", "missing")
+	}
+	if g.GenesisBlocks != nil {
+		for index, block := range g.GenesisBlocks.Blocks {
+			if block == nil {
+				errs.errorf(fmt.Sprintf("GenesisBlocks.Blocks[%v]", index), "missing")
+				continue
+			}
+			for _, zts := range sortedTokens(block.BalanceList) {
+				if amount := block.BalanceList[zts]; amount == nil || amount.Sign() < 0 {
+					errs.errorf(fmt.Sprintf("GenesisBlocks.Blocks[%v].BalanceList.%v", index, zts), "expected a non-negative amount but got %v", amount)
+				}
+			}
+		}
+	}
+	if g.TokenConfig != nil {
+		for index, token := range g.TokenConfig.Tokens {
+			if token == nil {
+				errs.errorf(fmt.Sprintf("TokenConfig.Tokens[%v]", index), "missing")
+			} else if token.TotalSupply == nil || token.MaxSupply == nil {
+				errs.errorf(fmt.Sprintf("TokenConfig.Tokens[%v]", index), "missing TotalSupply or MaxSupply for %v", token.TokenStandard)
+			}
+		}
 	}
-	return nil
 }
+
 func CheckPlasmaInfo(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validatePlasmaInfo(g, errs)
+	return errs.first()
+}
+func validatePlasmaInfo(g *GenesisConfig, errs *genesisErrors) {
+	if g.PlasmaConfig == nil {
+		return
+	}
 	totalAmount := big.NewInt(0)
 
-	for addr, fusion := range g.PlasmaConfig.Fusions {
-		if fusion == nil {
-			return errors.Errorf("nil FusionInfo for %v", addr)
+	for index, fusion := range g.PlasmaConfig.Fusions {
+		if fusion == nil || fusion.Amount == nil {
+			errs.errorf(fmt.Sprintf("PlasmaConfig.Fusions[%v]", index), "nil FusionInfo")
+			continue
 		}
 		totalAmount.Add(totalAmount, fusion.Amount)
 	}
 
-	return checkAccountBalance(g, types.PlasmaContract, map[types.ZenonTokenStandard]*big.Int{
-		types.QsrTokenStandard: totalAmount,
+	checkAccountBalance(g, errs, types.PlasmaContract, map[types.ZenonTokenStandard]*big.Int{
+		g.qsrTokenStandard(): totalAmount,
 	})
 }
+
 func CheckSwapAccount(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateSwapAccount(g, errs)
+	return errs.first()
+}
+func validateSwapAccount(g *GenesisConfig, errs *genesisErrors) {
+	if g.SwapConfig == nil {
+		return
+	}
 	given := map[types.ZenonTokenStandard]*big.Int{
-		types.ZnnTokenStandard: big.NewInt(0),
-		types.QsrTokenStandard: big.NewInt(0),
+		g.znnTokenStandard(): big.NewInt(0),
+		g.qsrTokenStandard(): big.NewInt(0),
 	}
 
-	for _, entry := range g.SwapConfig.Entries {
-		if entry.Qsr == nil || entry.Znn == nil {
-			return errors.Errorf("invalid swap balance for KeyIdHash %v", entry.KeyIdHash)
+	for index, entry := range g.SwapConfig.Entries {
+		if entry == nil || entry.Qsr == nil || entry.Znn == nil {
+			errs.errorf(fmt.Sprintf("SwapConfig.Entries[%v]", index), "invalid swap balance")
 		}
 	}
 
-	return checkAccountBalance(g, types.SwapContract, given)
+	checkAccountBalance(g, errs, types.SwapContract, given)
 }
+
 func CheckPillarBalance(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validatePillarBalance(g, errs)
+	return errs.first()
+}
+func validatePillarBalance(g *GenesisConfig, errs *genesisErrors) {
+	if g.PillarConfig == nil {
+		return
+	}
 	totalAmount := big.NewInt(0)
 
-	for _, el := range g.PillarConfig.Pillars {
+	for index, el := range g.PillarConfig.Pillars {
+		if el == nil || el.Amount == nil {
+			errs.errorf(fmt.Sprintf("PillarConfig.Pillars[%v]", index), "missing Amount")
+			continue
+		}
 		totalAmount.Add(totalAmount, el.Amount)
 	}
 
-	return checkAccountBalance(g, types.PillarContract, map[types.ZenonTokenStandard]*big.Int{
-		types.ZnnTokenStandard: totalAmount,
+	checkAccountBalance(g, errs, types.PillarContract, map[types.ZenonTokenStandard]*big.Int{
+		g.znnTokenStandard(): totalAmount,
 	})
 }
+
 func CheckTokenTotalSupply(g *GenesisConfig) error {
+	errs := new(genesisErrors)
+	validateTokenTotalSupply(g, errs)
+	return errs.first()
+}
+func validateTokenTotalSupply(g *GenesisConfig, errs *genesisErrors) {
+	if g.GenesisBlocks == nil || g.TokenConfig == nil {
+		return
+	}
 	given := make(map[types.ZenonTokenStandard]*big.Int)
 	for _, block := range g.GenesisBlocks.Blocks {
+		if block == nil {
+			continue
+		}
 		for zts, amount := range block.BalanceList {
+			if amount == nil {
+				continue
+			}
 			total, ok := given[zts]
 			if !ok {
 				given[zts] = new(big.Int).Set(amount)
@@ -130,29 +206,24 @@ func CheckTokenTotalSupply(g *GenesisConfig) error {
 		}
 	}
 
-	for _, token := range g.TokenConfig.Tokens {
+	for index, token := range g.TokenConfig.Tokens {
+		if token == nil || token.TotalSupply == nil {
+			continue
+		}
+		path := fmt.Sprintf("TokenConfig.Tokens[%v]", index)
 		total, ok := given[token.TokenStandard]
 		if !ok {
-			return errors.Errorf("token %v declared but not given at all", token)
+			errs.errorf(path, "token %v declared but not given at all", token.TokenStandard)
 		} else if token.TotalSupply.Cmp(total) != 0 {
-			return errors.Errorf("invalid token total balance for %v Expected %v but got %v", token, total, token.TotalSupply)
+			errs.errorf(path+".TotalSupply", "invalid token total balance for %v Expected %v but got %v", token.TokenStandard, total, token.TotalSupply)
 		}
 	}
 
-	for zts := range given {
-		found := false
-		for _, token := range g.TokenConfig.Tokens {
-			if token.TokenStandard == zts {
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			return errors.Errorf("invalid token %v given but not declared", zts)
+	for _, zts := range sortedTokens(given) {
+		if findToken(g, zts) == nil {
+			errs.errorf("GenesisBlocks.Blocks", "invalid token %v given but not declared", zts)
 		}
 	}
-	return nil
 }
 
 // CheckGenesisCheckSum ensures that the hash of the account blocks don't change during the build.
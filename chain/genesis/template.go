@@ -0,0 +1,134 @@
+package genesis
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+var (
+	templateSporkZnn     = big.NewInt(1000 * constants.Decimals)
+	templateSporkQsr     = big.NewInt(10000 * constants.Decimals)
+	templatePillarFusion = big.NewInt(10000 * constants.Decimals)
+)
+
+// TemplateConfig describes the genesis generated by MakeTemplate
+type TemplateConfig struct {
+	ChainIdentifier     uint64
+	GenesisTimestampSec int64
+	SporkAddress        types.Address
+	// Pillars are the stake, producing and reward addresses of the genesis pillars
+	Pillars []types.Address
+}
+
+// MakeTemplate returns a valid genesis config which uses the mainnet tokens and parameters.
+// Each pillar delegates to itself and fuses QSR for itself, the spork address receives some ZNN and QSR.
+func MakeTemplate(cfg *TemplateConfig) *GenesisConfig {
+	sporkAddress := cfg.SporkAddress
+	pillarsZnn := big.NewInt(0)
+	fusionsQsr := big.NewInt(0)
+
+	pillarConfig := &PillarContractConfig{
+		Pillars:       []*definition.PillarInfo{},
+		Delegations:   []*definition.DelegationInfo{},
+		LegacyEntries: []*definition.LegacyPillarEntry{},
+	}
+	plasmaConfig := &PlasmaContractConfig{
+		Fusions: []*definition.FusionInfo{},
+	}
+	for index, address := range cfg.Pillars {
+		name := fmt.Sprintf("Pillar%v", index+1)
+		pillarConfig.Pillars = append(pillarConfig.Pillars, &definition.PillarInfo{
+			Name:                         name,
+			BlockProducingAddress:        address,
+			StakeAddress:                 address,
+			RewardWithdrawAddress:        address,
+			Amount:                       new(big.Int).Set(constants.PillarStakeAmount),
+			RegistrationTime:             cfg.GenesisTimestampSec,
+			GiveBlockRewardPercentage:    0,
+			GiveDelegateRewardPercentage: 100,
+			PillarType:                   definition.NormalPillarType,
+		})
+		pillarConfig.Delegations = append(pillarConfig.Delegations, &definition.DelegationInfo{
+			Name:   name,
+			Backer: address,
+		})
+		plasmaConfig.Fusions = append(plasmaConfig.Fusions, &definition.FusionInfo{
+			Owner:       address,
+			Amount:      new(big.Int).Set(templatePillarFusion),
+			Beneficiary: address,
+		})
+		pillarsZnn.Add(pillarsZnn, constants.PillarStakeAmount)
+		fusionsQsr.Add(fusionsQsr, templatePillarFusion)
+	}
+
+	blocks := []*GenesisBlockConfig{
+		{
+			Address: sporkAddress,
+			BalanceList: map[types.ZenonTokenStandard]*big.Int{
+				types.ZnnTokenStandard: new(big.Int).Set(templateSporkZnn),
+				types.QsrTokenStandard: new(big.Int).Set(templateSporkQsr),
+			},
+		},
+	}
+	if len(cfg.Pillars) != 0 {
+		blocks = append(blocks, &GenesisBlockConfig{
+			Address:     types.PillarContract,
+			BalanceList: map[types.ZenonTokenStandard]*big.Int{types.ZnnTokenStandard: pillarsZnn},
+		}, &GenesisBlockConfig{
+			Address:     types.PlasmaContract,
+			BalanceList: map[types.ZenonTokenStandard]*big.Int{types.QsrTokenStandard: fusionsQsr},
+		})
+	}
+
+	return &GenesisConfig{
+		ChainIdentifier:     cfg.ChainIdentifier,
+		ExtraData:           "",
+		GenesisTimestampSec: cfg.GenesisTimestampSec,
+		SporkAddress:        &sporkAddress,
+		PillarConfig:        pillarConfig,
+		TokenConfig: &TokenContractConfig{
+			Tokens: []*definition.TokenInfo{
+				{
+					Owner:         types.PillarContract,
+					TokenName:     "Zenon Coin",
+					TokenSymbol:   "ZNN",
+					TokenDomain:   "zenon.network",
+					TotalSupply:   new(big.Int).Add(pillarsZnn, templateSporkZnn),
+					MaxSupply:     new(big.Int).Set(constants.TokenMaxSupplyBig),
+					Decimals:      constants.NumDecimals,
+					IsMintable:    true,
+					IsBurnable:    true,
+					IsUtility:     true,
+					TokenStandard: types.ZnnTokenStandard,
+				},
+				{
+					Owner:         types.StakeContract,
+					TokenName:     "QuasarCoin",
+					TokenSymbol:   "QSR",
+					TokenDomain:   "zenon.network",
+					TotalSupply:   new(big.Int).Add(fusionsQsr, templateSporkQsr),
+					MaxSupply:     new(big.Int).Set(constants.TokenMaxSupplyBig),
+					Decimals:      constants.NumDecimals,
+					IsMintable:    true,
+					IsBurnable:    true,
+					IsUtility:     true,
+					TokenStandard: types.QsrTokenStandard,
+				},
+			},
+		},
+		PlasmaConfig: plasmaConfig,
+		SwapConfig: &SwapContractConfig{
+			Entries: []*definition.SwapAssets{},
+		},
+		SporkConfig: &SporkConfig{
+			Sporks: []*definition.Spork{},
+		},
+		GenesisBlocks: &GenesisBlocksConfig{
+			Blocks: blocks,
+		},
+	}
+}
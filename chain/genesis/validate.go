@@ -0,0 +1,68 @@
+package genesis
+
+import (
+	"fmt"
+)
+
+// GenesisError is an invalid field of a genesis config, located by its JSON path
+type GenesisError struct {
+	Path    string
+	Message string
+}
+
+func (e *GenesisError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Path, e.Message)
+}
+
+func genesisErrorf(path string, format string, args ...interface{}) *GenesisError {
+	return &GenesisError{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// genesisErrors collects the errors of all the genesis checks
+type genesisErrors struct {
+	list []*GenesisError
+}
+
+func (e *genesisErrors) add(err *GenesisError) {
+	e.list = append(e.list, err)
+}
+func (e *genesisErrors) errorf(path string, format string, args ...interface{}) {
+	e.add(genesisErrorf(path, format, args...))
+}
+
+// addError records an error returned by a helper, keeping its path if it's a GenesisError
+func (e *genesisErrors) addError(path string, err error) {
+	if genesisErr, ok := err.(*GenesisError); ok {
+		e.add(genesisErr)
+	} else {
+		e.errorf(path, "%v", err)
+	}
+}
+
+// first returns the first error found, used by the Check* functions
+func (e *genesisErrors) first() error {
+	if len(e.list) == 0 {
+		return nil
+	}
+	return e.list[0]
+}
+
+// ValidateGenesis runs every genesis check and returns all the errors found, in the order of CheckGenesis.
+// Returns an empty list for valid genesis configs.
+func ValidateGenesis(g *GenesisConfig) []*GenesisError {
+	errs := new(genesisErrors)
+	validateFieldsExist(g, errs)
+	validateHyperQube(g, errs)
+	validateContractParameters(g, errs)
+	validateEmbeddedContracts(g, errs)
+	validateRewardSchedule(g, errs)
+	validatePlasmaInfo(g, errs)
+	validateSwapAccount(g, errs)
+	validatePillarBalance(g, errs)
+	validateTokenTotalSupply(g, errs)
+	validateAuthority(g, errs)
+	return errs.list
+}
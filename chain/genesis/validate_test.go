@@ -0,0 +1,85 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+func TestValidateGenesisReportsAllErrors(t *testing.T) {
+	config := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(emptyGenesisJsonStr, config))
+	common.FailIfErr(t, CheckGenesis(config))
+	common.ExpectUint64(t, uint64(len(ValidateGenesis(config))), 0)
+
+	config.SwapConfig = nil
+	config.ContractParameters = &ContractParametersConfig{PillarStakeAmount: big.NewInt(0), FuseMinAmount: big.NewInt(-1)}
+	config.RewardSchedule = newRewardScheduleTestConfig()
+	config.RewardSchedule.TickDurationInEpochs = 0
+	config.PlasmaConfig.Fusions = []*definition.FusionInfo{nil}
+	config.GenesisBlocks.Blocks = []*GenesisBlockConfig{
+		{
+			Address:     types.PlasmaContract,
+			BalanceList: map[types.ZenonTokenStandard]*big.Int{types.QsrTokenStandard: big.NewInt(1)},
+		},
+	}
+
+	common.ExpectJson(t, ValidateGenesis(config), `
+[
+	{
+		"Path": "SwapConfig",
+		"Message": "missing"
+	},
+	{
+		"Path": "ContractParameters.PillarStakeAmount",
+		"Message": "expected a positive amount but got 0"
+	},
+	{
+		"Path": "ContractParameters.FuseMinAmount",
+		"Message": "expected a positive amount but got -1"
+	},
+	{
+		"Path": "RewardSchedule.TickDurationInEpochs",
+		"Message": "expected a positive number of epochs"
+	},
+	{
+		"Path": "PlasmaConfig.Fusions[0]",
+		"Message": "nil FusionInfo"
+	},
+	{
+		"Path": "GenesisBlocks.Blocks[0].BalanceList.zts1qsrxxxxxxxxxxxxxmrhjll",
+		"Message": "invalid balance for z1qxemdeddedxplasmaxxxxxxxxxxxxxxxxsctrp Expected 0 zts1qsrxxxxxxxxxxxxxmrhjll but got 1"
+	},
+	{
+		"Path": "GenesisBlocks.Blocks",
+		"Message": "invalid token zts1qsrxxxxxxxxxxxxxmrhjll given but not declared"
+	}
+]`)
+	common.ExpectString(t, CheckGenesis(config).Error(), "SwapConfig: missing")
+}
+
+func TestMakeTemplate(t *testing.T) {
+	config := MakeTemplate(&TemplateConfig{
+		ChainIdentifier:     7,
+		GenesisTimestampSec: 1000000000,
+		SporkAddress:        types.ParseAddressPanic("z1qph8dkja68pg3g6j4spwk9re0kjdkul0amwqnt"),
+		Pillars: []types.Address{
+			types.ParseAddressPanic("z1qqmqp40duzvtxvg7dwxph7724mq63t3mru297p"),
+			types.ParseAddressPanic("z1qp004vzesjnnxhcmlkwvyefj4gnr6a0vyh94sk"),
+		},
+	})
+	common.ExpectUint64(t, uint64(len(ValidateGenesis(config))), 0)
+	common.ExpectUint64(t, NewGenesis(config).ChainIdentifier(), 7)
+
+	// The template must survive a JSON round trip
+	data, err := json.Marshal(config)
+	common.FailIfErr(t, err)
+	decoded := new(GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(data, decoded))
+	common.FailIfErr(t, CheckGenesis(decoded))
+	common.ExpectString(t, NewGenesis(decoded).GetGenesisMomentum().Hash.String(), NewGenesis(config).GetGenesisMomentum().Hash.String())
+}
@@ -40,7 +40,18 @@ type MomentumPool interface {
 	RollbackTo(insertLocker sync.Locker, identifier types.HashHeight) error
 
 	GetFrontierMomentumStore() store.Momentum
-	GetMomentumStore(identifier types.HashHeight) store.Momentum
+	// GetMomentumStore returns nil, nil for unknown identifiers and db.ErrPruned for the states deleted by the pruning mode
+	GetMomentumStore(identifier types.HashHeight) (store.Momentum, error)
+	// GetMomentumChanges returns the changes applied by the momentum, which hash to its ChangesHash.
+	// Returns nil, nil for unknown identifiers and db.ErrPruned for the momentums deleted by the pruning mode
+	GetMomentumChanges(identifier types.HashHeight) (db.Patch, error)
+	// GetStateEntries returns the entries of the state of the momentum, see db.DumpDBFrom.
+	// Returns db.ErrPruned for the states deleted by the pruning mode and for all the states of the pruned chains,
+	// which miss the bodies of the old account-blocks
+	GetStateEntries(identifier types.HashHeight, from []byte, limit int) (db.Patch, []byte, error)
+	// InstallState replaces a chain which has only the genesis momentum with state, the state of its frontier momentum.
+	// The states of the previous momentums are reported as pruned.
+	InstallState(insertLocker sync.Locker, state db.DB) error
 }
 
 type AccountPool interface {
@@ -56,7 +67,8 @@ type AccountPool interface {
 	ForceAddAccountBlockTransaction(insertLocker sync.Locker, transaction *nom.AccountBlockTransaction) error
 
 	GetPatch(address types.Address, identifier types.HashHeight) db.Patch
-	GetAccountStore(address types.Address, identifier types.HashHeight) store.Account
+	// GetAccountStore returns nil, nil for unavailable identifiers and db.ErrPruned for the account-blocks deleted by the pruning mode
+	GetAccountStore(address types.Address, identifier types.HashHeight) (store.Account, error)
 	GetFrontierAccountStore(address types.Address) store.Account
 
 	GetNewMomentumContent() []*nom.AccountBlock
@@ -18,6 +18,14 @@ func (ms *momentumStore) GetActivePillars() ([]*definition.PillarInfo, error) {
 
 	return definition.GetPillarsList(sd.Storage(), true, definition.AnyPillarType)
 }
+func (ms *momentumStore) GetAuthorities() ([]*definition.AuthorityInfo, error) {
+	sd, err := ms.getEmbeddedStore(types.AuthorityContract)
+	if err != nil {
+		return nil, fmt.Errorf("getEmbeddedStore failed: %w", err)
+	}
+
+	return definition.GetAuthorityList(sd.Storage())
+}
 func (ms *momentumStore) getAllDelegations() ([]*definition.DelegationInfo, error) {
 	sd, err := ms.getEmbeddedStore(types.PillarContract)
 	if err != nil {
@@ -131,7 +139,28 @@ func (ms *momentumStore) GetAllDefinedSporks() ([]*definition.Spork, error) {
 
 	return definition.GetAllSporks(sd.Storage()), nil
 }
+func (ms *momentumStore) GetSporkConsensusParameters(id types.Hash) (*definition.SporkConsensusParameters, error) {
+	sd, err := ms.getEmbeddedStore(types.SporkContract)
+	if err != nil {
+		return nil, fmt.Errorf("getEmbeddedStore failed: %w", err)
+	}
+
+	return definition.GetSporkConsensusParametersById(sd.Storage(), id), nil
+}
+func (ms *momentumStore) GetAllSporkConsensusParameters() ([]*definition.SporkConsensusParameters, error) {
+	sd, err := ms.getEmbeddedStore(types.SporkContract)
+	if err != nil {
+		return nil, fmt.Errorf("getEmbeddedStore failed: %w", err)
+	}
+
+	return definition.GetAllSporkConsensusParameters(sd.Storage()), nil
+}
 func (ms *momentumStore) IsSporkActive(implemented *types.ImplementedSpork) (bool, error) {
+	// sporks activated by the genesis are enforced since the genesis momentum
+	if ms.GetChainParams().ActiveSporks[implemented.SporkId] {
+		return true, nil
+	}
+
 	frontier, err := ms.GetFrontierMomentum()
 	if err != nil {
 		return false, err
@@ -1,6 +1,9 @@
 package momentum
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 
@@ -11,11 +14,14 @@ import (
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 type momentumStore struct {
 	store.Genesis
 	db.DB
+	// chainParams are the params of the genesis store, which is used before its genesis exists
+	chainParams *constants.ChainParams
 }
 
 func getAccountStorePrefix(address types.Address) []byte {
@@ -25,6 +31,11 @@ func getAccountMailboxPrefix(address types.Address) []byte {
 	return common.JoinBytes(accountMailboxPrefix, address.Bytes())
 }
 
+// AccountBlockKey returns the key of the account-block body at height, used by the pruning mode
+func AccountBlockKey(address types.Address, height uint64) []byte {
+	return common.JoinBytes(getAccountStorePrefix(address), db.EntryByHeightKey(height))
+}
+
 func (ms *momentumStore) Snapshot() store.Momentum {
 	return NewStore(ms.Genesis, ms.DB.Snapshot())
 }
@@ -36,6 +47,40 @@ func (ms *momentumStore) GetAccountStore(address types.Address) store.Account {
 	return account.NewAccountStore(address, ms.GetAccountDB(address))
 }
 
+// GetAccountAddresses returns the addresses which have an account store, in ascending order
+func (ms *momentumStore) GetAccountAddresses() ([]types.Address, error) {
+	iterator := ms.DB.NewIterator(accountStorePrefix)
+	defer iterator.Release()
+	seen := make(map[types.Address]bool)
+	addresses := make([]types.Address, 0)
+
+	for {
+		if !iterator.Next() {
+			if iterator.Error() != nil {
+				return nil, iterator.Error()
+			}
+			break
+		}
+		key := iterator.Key()
+		if iterator.Value() == nil || len(key) < 1+types.AddressSize {
+			continue
+		}
+
+		address, err := types.BytesToAddress(key[1 : 1+types.AddressSize])
+		if err != nil {
+			return nil, err
+		}
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses, nil
+}
+
 func (ms *momentumStore) getAccountMailbox(address types.Address) store.AccountMailbox {
 	return mailbox.NewAccountMailbox(address, ms.DB.Subset(getAccountMailboxPrefix(address)))
 }
@@ -55,7 +100,7 @@ func (ms *momentumStore) AddAccountBlockTransaction(header types.AccountHeader,
 
 	// Set znn balance
 	accountStore := ms.GetAccountStore(header.Address)
-	znnBalance, err := accountStore.GetBalance(types.ZnnTokenStandard)
+	znnBalance, err := accountStore.GetBalance(ms.GetChainParams().ZnnTokenStandard)
 	if err != nil {
 		return err
 	}
@@ -126,6 +171,13 @@ func (ms *momentumStore) Identifier() types.HashHeight {
 	}
 }
 
+func (ms *momentumStore) GetChainParams() *constants.ChainParams {
+	if ms.Genesis == nil {
+		return ms.chainParams
+	}
+	return ms.Genesis.GetChainParams()
+}
+
 func NewStore(genesis store.Genesis, db db.DB) store.Momentum {
 	if db == nil {
 		panic("momentum store can't operate with nil db")
@@ -135,9 +187,12 @@ func NewStore(genesis store.Genesis, db db.DB) store.Momentum {
 		DB:      db,
 	}
 }
-func NewGenesisStore() store.Momentum {
+
+// NewGenesisStore returns the store in which the genesis momentum is generated, with the params derived from the genesis
+func NewGenesisStore(chainParams *constants.ChainParams) store.Momentum {
 	return &momentumStore{
-		Genesis: nil,
-		DB:      db.NewMemDB(),
+		Genesis:     nil,
+		DB:          db.NewMemDB(),
+		chainParams: chainParams,
 	}
 }
@@ -23,6 +23,9 @@ type momentumPool struct {
 	genesis      store.Genesis
 	log          log15.Logger
 	changes      sync.Mutex
+
+	// number of momentums kept by the pruning mode, 0 keeps the whole history
+	pruning uint64
 }
 
 func (c *momentumPool) AddMomentumTransaction(insertLocker sync.Locker, transaction *nom.MomentumTransaction) error {
@@ -73,6 +76,9 @@ func (c *momentumPool) AddMomentumTransaction(insertLocker sync.Locker, transact
 		fmt.Printf("\n")
 	}
 
+	if err := c.prune(); err != nil {
+		c.log.Error("failed to prune", "reason", err)
+	}
 	return nil
 }
 func (c *momentumPool) RollbackTo(insertLocker sync.Locker, identifier types.HashHeight) error {
@@ -135,6 +141,14 @@ func GotAllActiveSporksImplemented(store store.Momentum) (justNow *definition.Sp
 	for _, spork := range sporks {
 		if spork.Activated && spork.EnforcementHeight <= momentum.Height {
 			_, ok := types.ImplementedSporksMap[spork.Id]
+			if !ok {
+				// consensus parameters sporks are implemented by the consensus
+				parameters, err := store.GetSporkConsensusParameters(spork.Id)
+				if err != nil {
+					return nil, nil, err
+				}
+				ok = parameters != nil
+			}
 			if !ok {
 				unimplemented = append(unimplemented, spork)
 			}
@@ -163,15 +177,37 @@ func (c *momentumPool) GetFrontierMomentumStore() store.Momentum {
 	defer c.changes.Unlock()
 	return c.getFrontierStore()
 }
-func (c *momentumPool) GetMomentumStore(identifier types.HashHeight) store.Momentum {
+func (c *momentumPool) GetMomentumStore(identifier types.HashHeight) (store.Momentum, error) {
 	c.changes.Lock()
 	defer c.changes.Unlock()
 	momentumDB := c.chainManager.Get(identifier)
 	if momentumDB == nil {
-		return nil
+		if identifier.Height < c.chainManager.PrunedHeight() {
+			return nil, db.ErrPruned
+		}
+		return nil, nil
 	}
 
-	return momentum.NewStore(c.genesis, momentumDB)
+	return momentum.NewStore(c.genesis, momentumDB), nil
+}
+func (c *momentumPool) GetMomentumChanges(identifier types.HashHeight) (db.Patch, error) {
+	c.changes.Lock()
+	defer c.changes.Unlock()
+	if identifier.Height < c.chainManager.PrunedHeight() {
+		return nil, db.ErrPruned
+	}
+	momentum, err := c.getFrontierStore().GetMomentumByHeight(identifier.Height)
+	if err != nil {
+		return nil, err
+	}
+	if momentum == nil || momentum.Hash != identifier.Hash {
+		return nil, nil
+	}
+	patch := c.chainManager.GetPatch(identifier)
+	if patch == nil {
+		return nil, nil
+	}
+	return db.CommitChanges(patch)
 }
 func (c *momentumPool) GetStableAccountDB(address types.Address) db.DB {
 	c.changes.Lock()
@@ -179,11 +215,29 @@ func (c *momentumPool) GetStableAccountDB(address types.Address) db.DB {
 	return c.getFrontierStore().GetAccountDB(address)
 }
 
-func NewMomentumPool(chainManager db.Manager, genesis store.Genesis) *momentumPool {
+func (c *momentumPool) CheckPruned(hash types.Hash) error {
+	c.changes.Lock()
+	defer c.changes.Unlock()
+	prunedHeight := c.chainManager.PrunedHeight()
+	if prunedHeight == 0 {
+		return nil
+	}
+	confirmationHeight, err := c.getFrontierStore().GetBlockConfirmationHeight(hash)
+	if err != nil {
+		return err
+	}
+	if confirmationHeight != 0 && confirmationHeight < prunedHeight {
+		return db.ErrPruned
+	}
+	return nil
+}
+
+func NewMomentumPool(chainManager db.Manager, genesis store.Genesis, pruning uint64) *momentumPool {
 	return &momentumPool{
 		momentumEventManager: newMomentumEventManager(),
 		chainManager:         chainManager,
 		genesis:              genesis,
 		log:                  common.ChainLogger.New("submodule", "momentum-pool"),
+		pruning:              pruning,
 	}
 }
@@ -0,0 +1,149 @@
+package chain
+
+import (
+	"github.com/zenon-network/go-zenon/chain/momentum"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	// MinPruningRetention is the smallest number of momentums kept by the pruning mode.
+	// Pruned nodes can't verify account-blocks which acknowledge older momentums.
+	MinPruningRetention = uint64(8640)
+	// maxPruneStep limits the heights pruned after each momentum, so enabling the pruning mode on a synced node doesn't stall it
+	maxPruneStep = uint64(1000)
+)
+
+// prune deletes the states older than the retention window, together with the bodies of the account-blocks they confirmed.
+// Must be called with c.changes locked.
+func (c *momentumPool) prune() error {
+	if c.pruning == 0 {
+		return nil
+	}
+	frontierStore := c.getFrontierStore()
+	frontier := frontierStore.Identifier()
+	if frontier.Height <= c.pruning {
+		return nil
+	}
+
+	from := c.chainManager.PrunedHeight()
+	to := frontier.Height - c.pruning
+	if to > from+maxPruneStep {
+		to = from + maxPruneStep
+	}
+	if to <= from {
+		return nil
+	}
+
+	pruner := newBodyPruner(frontierStore, to)
+	for height := from; height < to; height += 1 {
+		if height == 0 {
+			continue
+		}
+		m, err := frontierStore.GetMomentumByHeight(height)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			continue
+		}
+		for _, header := range m.Content {
+			if err := pruner.add(*header); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.log.Debug("pruning", "from-height", from, "to-height", to, "account-blocks", len(pruner.keys))
+	return c.chainManager.Prune(to, pruner.keys)
+}
+
+// bodyPruner collects the keys of the account-block bodies which can be deleted.
+// The bodies of the account frontiers and of the unreceived send blocks are kept,
+// they are collected later together with the block which follows them or receives them.
+type bodyPruner struct {
+	store store.Momentum
+	below uint64
+	keys  [][]byte
+	seen  map[types.Hash]bool
+}
+
+func newBodyPruner(store store.Momentum, below uint64) *bodyPruner {
+	return &bodyPruner{
+		store: store,
+		below: below,
+		keys:  make([][]byte, 0),
+		seen:  make(map[types.Hash]bool),
+	}
+}
+
+func (p *bodyPruner) add(header types.AccountHeader) error {
+	queue := []types.AccountHeader{header}
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if p.seen[current.Hash] {
+			continue
+		}
+		p.seen[current.Hash] = true
+
+		block, err := p.store.GetAccountBlock(current)
+		if err != nil {
+			return err
+		}
+		// already pruned
+		if block == nil || block.Hash != current.Hash {
+			continue
+		}
+		if ok, err := p.prunable(block); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		p.keys = append(p.keys, momentum.AccountBlockKey(block.Address, block.Height))
+
+		if block.Height > 1 {
+			queue = append(queue, types.AccountHeader{
+				Address:    block.Address,
+				HashHeight: types.HashHeight{Hash: block.PreviousHash, Height: block.Height - 1},
+			})
+		}
+		if block.IsReceiveBlock() && block.BlockType != nom.BlockTypeGenesisReceive {
+			fromBlock, err := p.store.GetAccountBlockByHash(block.FromBlockHash)
+			if err != nil {
+				return err
+			}
+			if fromBlock != nil {
+				queue = append(queue, fromBlock.Header())
+			}
+		}
+		for _, descendant := range block.DescendantBlocks {
+			queue = append(queue, descendant.Header())
+		}
+	}
+	return nil
+}
+
+func (p *bodyPruner) prunable(block *nom.AccountBlock) (bool, error) {
+	confirmationHeight, err := p.store.GetBlockConfirmationHeight(block.Hash)
+	if err != nil {
+		return false, err
+	}
+	if confirmationHeight == 0 || confirmationHeight >= p.below {
+		return false, nil
+	}
+
+	frontier, err := p.store.GetFrontierAccountBlock(block.Address)
+	if err != nil {
+		return false, err
+	}
+	if frontier == nil || frontier.Hash == block.Hash {
+		return false, nil
+	}
+
+	if block.IsSendBlock() && p.store.GetAccountMailbox(block.Address).GetBlockWhichReceives(block.Hash) == nil {
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	// DefaultFinalityDepth is the default number of momentums which the rollback command can remove
+	DefaultFinalityDepth = uint64(8640)
+)
+
+var (
+	ErrRollbackTargetMissing  = errors.New("rollback target is not part of the chain")
+	ErrRollbackTargetFrontier = errors.New("rollback target is not older than the frontier momentum")
+	ErrRollbackTooDeep        = errors.New("rollback crosses the finality depth")
+)
+
+// RollbackSummary describes what a rollback to the momentum To removes
+type RollbackSummary struct {
+	From          types.HashHeight `json:"from"`
+	To            types.HashHeight `json:"to"`
+	Momentums     uint64           `json:"momentums"`
+	AccountBlocks uint64           `json:"accountBlocks"`
+}
+
+// PlanRollback checks that the chain can be rolled back to target, without removing more than finalityDepth momentums,
+// and counts the momentums and account-blocks which are removed.
+func PlanRollback(chain Chain, target types.HashHeight, finalityDepth uint64) (*RollbackSummary, error) {
+	momentumStore := chain.GetFrontierMomentumStore()
+	frontier, err := momentumStore.GetFrontierMomentum()
+	if err != nil {
+		return nil, err
+	}
+	momentum, err := momentumStore.GetMomentumByHeight(target.Height)
+	if err != nil {
+		return nil, err
+	}
+	if momentum == nil || momentum.Hash != target.Hash {
+		return nil, errors.Wrapf(ErrRollbackTargetMissing, "momentum %v", target)
+	}
+	if target.Height >= frontier.Height {
+		return nil, errors.Wrapf(ErrRollbackTargetFrontier, "target %v frontier %v", target, frontier.Identifier())
+	}
+	if frontier.Height-target.Height > finalityDepth {
+		return nil, errors.Wrapf(ErrRollbackTooDeep, "removes %v momentums but the finality depth is %v", frontier.Height-target.Height, finalityDepth)
+	}
+	// fails with db.ErrPruned if the target state was deleted by the pruning mode
+	if _, err := chain.GetMomentumStore(target); err != nil {
+		return nil, err
+	}
+
+	summary := &RollbackSummary{
+		From:      frontier.Identifier(),
+		To:        target,
+		Momentums: frontier.Height - target.Height,
+	}
+	for height := target.Height + 1; height <= frontier.Height; height += 1 {
+		removed, err := momentumStore.GetMomentumByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		summary.AccountBlocks += uint64(len(removed.Content))
+	}
+	return summary, nil
+}
@@ -0,0 +1,52 @@
+package chain
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/momentum"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func (c *momentumPool) GetStateEntries(identifier types.HashHeight, from []byte, limit int) (db.Patch, []byte, error) {
+	c.changes.Lock()
+	defer c.changes.Unlock()
+	if c.pruning != 0 || identifier.Height < c.chainManager.PrunedHeight() {
+		return nil, nil, db.ErrPruned
+	}
+	momentumDB := c.chainManager.Get(identifier)
+	if momentumDB == nil {
+		return nil, nil, errors.Errorf("unknown momentum %v", identifier)
+	}
+	return db.DumpDBFrom(momentumDB, from, limit, nil)
+}
+func (c *momentumPool) InstallState(insertLocker sync.Locker, state db.DB) error {
+	if insertLocker == nil {
+		return errors.Errorf("insertLocker can't be nil")
+	}
+	c.changes.Lock()
+	defer c.changes.Unlock()
+
+	frontier, err := momentum.NewStore(c.genesis, state).GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+	if frontier == nil {
+		return errors.Errorf("can't install a state without frontier momentum")
+	}
+	c.log.Info("installing state", "identifier", frontier.Identifier())
+	if err := c.chainManager.Install(frontier.Identifier(), state); err != nil {
+		return err
+	}
+
+	detailed, err := c.getFrontierStore().PrefetchMomentum(frontier)
+	if err != nil {
+		return err
+	}
+	c.changes.Unlock()
+	c.broadcastInsertMomentum(detailed)
+	c.changes.Lock()
+	return nil
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 type Genesis interface {
@@ -11,4 +12,5 @@ type Genesis interface {
 	GetGenesisMomentum() *nom.Momentum
 	GetGenesisTransaction() *nom.MomentumTransaction
 	GetSporkAddress() *types.Address
+	GetChainParams() *constants.ChainParams
 }
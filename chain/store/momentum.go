@@ -42,13 +42,17 @@ type Momentum interface {
 	// Embedded
 
 	GetAllDefinedSporks() ([]*definition.Spork, error)
+	GetSporkConsensusParameters(id types.Hash) (*definition.SporkConsensusParameters, error)
+	GetAllSporkConsensusParameters() ([]*definition.SporkConsensusParameters, error)
 	GetActivePillars() ([]*definition.PillarInfo, error)
+	GetAuthorities() ([]*definition.AuthorityInfo, error)
 	IsSporkActive(*types.ImplementedSpork) (bool, error)
 	GetStakeBeneficialAmount(addr types.Address) (*big.Int, error)
 	GetTokenInfoByTs(ts types.ZenonTokenStandard) (*definition.TokenInfo, error)
 	ComputePillarDelegations() ([]*types.PillarDelegationDetail, error)
 
 	GetAccountStore(address types.Address) Account
+	GetAccountAddresses() ([]types.Address, error)
 	GetAccountDB(address types.Address) db.DB
 	GetAccountMailbox(address types.Address) AccountMailbox
 
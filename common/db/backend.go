@@ -0,0 +1,118 @@
+package db
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	LevelDBBackend = "leveldb"
+	PebbleBackend  = "pebble"
+
+	migrateBatchSize = 10000
+)
+
+var (
+	ErrUnknownBackend   = errors.New("unknown db backend")
+	ErrMigrateDivergent = errors.New("migrated db has a different frontier")
+)
+
+// CheckBackend fails if backend is not supported, the empty backend is leveldb
+func CheckBackend(backend string) error {
+	switch backend {
+	case "", LevelDBBackend, PebbleBackend:
+		return nil
+	}
+	return errors.Wrapf(ErrUnknownBackend, "%v, expected %v or %v", backend, LevelDBBackend, PebbleBackend)
+}
+
+// NewManager opens the versioned DB stored in dir with backend, the empty backend is leveldb
+func NewManager(backend string, dir string) (Manager, error) {
+	if err := CheckBackend(backend); err != nil {
+		return nil, err
+	}
+	if backend == PebbleBackend {
+		return NewPebbleManager(dir), nil
+	}
+	return NewLevelDBManager(dir), nil
+}
+
+// MigrateLevelDBToPebble copies every key of the versioned DB stored with leveldb in src to a new pebble DB in dst.
+// Fails if dst already exists, a failed migration deletes dst.
+// Returns the number of copied keys.
+func MigrateLevelDBToPebble(src, dst string, progress func(keys uint64)) (uint64, error) {
+	if _, err := os.Stat(src); err != nil {
+		return 0, err
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return 0, errors.Errorf("%v already exists", dst)
+	}
+	ldb, err := leveldb.OpenFile(src, &opt.Options{
+		ReadOnly:               true,
+		ErrorIfMissing:         true,
+		OpenFilesCacheCapacity: getOpenFilesCacheCapacity(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer ldb.Close()
+	pdb, err := openPebble(dst, true)
+	if err != nil {
+		return 0, err
+	}
+	storage := newPebbleStorage(pdb)
+
+	keys, err := copyRawStorage(&levelDBStorage{DB: ldb}, storage, progress)
+	if closeErr := storage.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if removeErr := os.RemoveAll(dst); removeErr != nil {
+			return 0, errors.Wrapf(err, "failed to delete %v: %v", dst, removeErr)
+		}
+		return 0, err
+	}
+	return keys, nil
+}
+
+func copyRawStorage(from, to rawStorage, progress func(keys uint64)) (uint64, error) {
+	snapshot, err := from.GetRawSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer snapshot.Release()
+
+	iterator := snapshot.NewIterator(nil, nil)
+	defer iterator.Release()
+	batch := new(leveldb.Batch)
+	keys := uint64(0)
+	for iterator.Next() {
+		batch.Put(iterator.Key(), iterator.Value())
+		keys += 1
+		if batch.Len() >= migrateBatchSize {
+			if err := to.Write(batch, nil); err != nil {
+				return 0, err
+			}
+			batch.Reset()
+			if progress != nil {
+				progress(keys)
+			}
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return 0, err
+	}
+	if err := to.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return 0, err
+	}
+
+	fromFrontier := GetFrontierIdentifier(newLevelDBROWrapper(snapshot).Subset(frontierByte))
+	toFrontier := GetFrontierIdentifier(newLevelDBLikeWrapper(to).Subset(frontierByte))
+	if fromFrontier != toFrontier {
+		return 0, errors.Wrapf(ErrMigrateDivergent, "expected %v but got %v", fromFrontier, toFrontier)
+	}
+	return keys, nil
+}
@@ -42,6 +42,8 @@ type DB interface {
 	Delete(key []byte) error
 
 	NewIterator(prefix []byte) StorageIterator
+	// NewIteratorFrom iterates the keys with prefix which aren't smaller than start
+	NewIteratorFrom(prefix []byte, start []byte) StorageIterator
 	Subset(prefix []byte) DB
 
 	Apply(Patch) error
@@ -55,6 +57,7 @@ type db interface {
 	Put(key, value []byte) error
 
 	NewIterator(prefix []byte) StorageIterator
+	newIteratorFrom(prefix []byte, start []byte) StorageIterator
 
 	changesInternal(prefix []byte) (Patch, error)
 }
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"runtime"
 
 	"github.com/syndtr/goleveldb/leveldb"
@@ -22,6 +23,15 @@ func getConsensusOpenFilesCacheCapacity() int {
 	}
 }
 
+// prefixRangeFrom returns the range of the keys with prefix which aren't smaller than start
+func prefixRangeFrom(prefix []byte, start []byte) *util.Range {
+	slice := util.BytesPrefix(prefix)
+	if bytes.Compare(start, slice.Start) > 0 {
+		slice.Start = start
+	}
+	return slice
+}
+
 type LevelDBLikeRO interface {
 	Get(key []byte, ro *opt.ReadOptions) (value []byte, err error)
 	Has(key []byte, ro *opt.ReadOptions) (ret bool, err error)
@@ -47,6 +57,9 @@ func (ro *levelDBROWrapper) changesInternal(prefix []byte) (Patch, error) {
 func (ro *levelDBROWrapper) NewIterator(prefix []byte) StorageIterator {
 	return ro.db.NewIterator(util.BytesPrefix(prefix), nil)
 }
+func (ro *levelDBROWrapper) newIteratorFrom(prefix []byte, start []byte) StorageIterator {
+	return ro.db.NewIterator(prefixRangeFrom(prefix, start), nil)
+}
 
 type LevelDBLike interface {
 	LevelDBLikeRO
@@ -69,12 +82,15 @@ func (ldbw *levelDBWrapper) Put(key, value []byte) error {
 func (ldbw *levelDBWrapper) NewIterator(prefix []byte) StorageIterator {
 	return ldbw.db.NewIterator(util.BytesPrefix(prefix), nil)
 }
+func (ldbw *levelDBWrapper) newIteratorFrom(prefix []byte, start []byte) StorageIterator {
+	return ldbw.db.NewIterator(prefixRangeFrom(prefix, start), nil)
+}
 
 func (ldbw *levelDBWrapper) changesInternal(prefix []byte) (Patch, error) {
 	panic("unimplemented")
 }
 
-func newLevelDBSnapshotWrapper(ldb *leveldb.Snapshot) db {
+func newLevelDBROSnapshot(ldb LevelDBLikeRO) db {
 	return newMergedDb([]db{
 		newMemDBInternal(),
 		&levelDBROWrapper{
@@ -83,22 +99,25 @@ func newLevelDBSnapshotWrapper(ldb *leveldb.Snapshot) db {
 	})
 }
 
+func newLevelDBROWrapper(ldb LevelDBLikeRO) DB {
+	return enableDelete(newLevelDBROSnapshot(ldb))
+}
+
 func NewLevelDBSnapshotWrapper(ldb *leveldb.Snapshot) DB {
-	return enableDelete(newMergedDb([]db{
-		newMemDBInternal(),
-		&levelDBROWrapper{
-			db: ldb,
-		},
-	}))
+	return newLevelDBROWrapper(ldb)
 }
 
-func NewLevelDBWrapper(db *leveldb.DB) DB {
+func newLevelDBLikeWrapper(db LevelDBLike) DB {
 	return enableDelete(
 		&levelDBWrapper{
 			db: db,
 		})
 }
 
+func NewLevelDBWrapper(db *leveldb.DB) DB {
+	return newLevelDBLikeWrapper(db)
+}
+
 func NewLevelDB(dirname string) (DB, *leveldb.DB) {
 	opts := &opt.Options{OpenFilesCacheCapacity: getConsensusOpenFilesCacheCapacity()}
 	db, err := leveldb.OpenFile(dirname, opts)
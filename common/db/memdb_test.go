@@ -1,6 +1,7 @@
 package db
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
@@ -89,6 +90,46 @@ func TestMergedIterators(t *testing.T) {
 000301 - 102005`)
 }
 
+// - the iterators start at the first key which isn't smaller than start
+// - the keys outside of the prefix and the deleted keys are skipped
+func TestIteratorFrom(t *testing.T) {
+	db1 := newMemDBInternal()
+	db1.Put([]byte{0, 1, 1}, []byte{0, 16})
+	db1.Put([]byte{0, 2, 1}, []byte{0})
+	db1.Put([]byte{1, 1, 1}, []byte{0, 17})
+	db2 := newMemDBInternal()
+	db2.Put([]byte{0, 1, 2}, []byte{0, 18})
+	db2.Put([]byte{0, 2, 1}, []byte{0, 19})
+	db2.Put([]byte{0, 3, 1}, []byte{0, 20})
+	db := enableDelete(newSkipDelete(newMergedDb([]db{db1, db2})))
+
+	keys := func(iterator StorageIterator) string {
+		defer iterator.Release()
+		s := ""
+		for iterator.Next() {
+			s = s + fmt.Sprintf("%x - %x\n", iterator.Key(), iterator.Value())
+		}
+		common.FailIfErr(t, iterator.Error())
+		return s
+	}
+	common.ExpectString(t, keys(db.NewIteratorFrom(nil, []byte{0, 1, 2})), `
+000102 - 12
+000301 - 14
+010101 - 11
+`)
+	common.ExpectString(t, keys(db.NewIteratorFrom([]byte{0}, []byte{0, 2})), `
+000301 - 14
+`)
+	common.ExpectString(t, keys(db.NewIteratorFrom([]byte{0, 1}, nil)), `
+000101 - 10
+000102 - 12
+`)
+	common.ExpectString(t, keys(db.Subset([]byte{0}).NewIteratorFrom(nil, []byte{1, 2})), `
+0102 - 12
+0301 - 14
+`)
+}
+
 func simpleMemDBOperations(t *testing.T, db DB) {
 	common.FailIfErr(t, db.Put([]byte{1, 2, 3}, []byte{1, 2, 3, 4, 5}))
 	common.FailIfErr(t, db.Put([]byte{1, 2, 3, 4}, []byte{1, 2, 3, 4, 5}))
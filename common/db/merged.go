@@ -45,6 +45,13 @@ func (u *mergedDB) NewIterator(prefix []byte) StorageIterator {
 	}
 	return newMergedIterator(iterators)
 }
+func (u *mergedDB) newIteratorFrom(prefix []byte, start []byte) StorageIterator {
+	iterators := make([]StorageIterator, len(u.dbs))
+	for i := range u.dbs {
+		iterators[i] = u.dbs[i].newIteratorFrom(prefix, start)
+	}
+	return newMergedIterator(iterators)
+}
 
 func (u *mergedDB) changesInternal(prefix []byte) (Patch, error) {
 	return u.dbs[0].changesInternal(prefix)
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -115,6 +116,35 @@ func (pa *patchApplierWO) Delete(key []byte) {
 	}
 }
 
+// frontierFilter drops the entries written by SetFrontier
+type frontierFilter struct {
+	Patch
+}
+
+func isFrontierKey(key []byte) bool {
+	return bytes.Equal(key, frontierIdentifierKey) || bytes.HasPrefix(key, heightByHashPrefix) || bytes.HasPrefix(key, entryByHeightPrefix)
+}
+func (ff *frontierFilter) Put(key []byte, value []byte) {
+	if !isFrontierKey(key) {
+		ff.Patch.Put(key, value)
+	}
+}
+func (ff *frontierFilter) Delete(key []byte) {
+	if !isFrontierKey(key) {
+		ff.Patch.Delete(key)
+	}
+}
+
+// CommitChanges returns the changes of a patch returned by Manager.GetPatch without the frontier entries
+// which the manager adds for the commit. These are the changes hashed by the commit, see PatchHash.
+func CommitChanges(patch Patch) (Patch, error) {
+	changes := NewPatch()
+	if err := patch.Replay(&frontierFilter{changes}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
 func DebugPatch(patch Patch) string {
 	pp := new(patchPrinter)
 	err := patch.Replay(pp)
@@ -166,6 +196,30 @@ func DumpDB(db DB) Patch {
 	return p
 }
 
+// DumpDBFrom returns the entries of db accepted by filter, in key order, starting at the first key which isn't smaller than from,
+// until their size reaches limit. next is the key of the first entry which isn't returned, nil after the last entry.
+// A nil filter accepts all the entries.
+func DumpDBFrom(db DB, from []byte, limit int, filter func(key []byte) bool) (Patch, []byte, error) {
+	p := NewPatch()
+	size := 0
+	iterator := db.NewIteratorFrom(nil, from)
+	defer iterator.Release()
+	for iterator.Next() {
+		key := iterator.Key()
+		value := iterator.Value()
+		// deleted entries are still iterated, with a nil value
+		if value == nil || (filter != nil && !filter(key)) {
+			continue
+		}
+		if size >= limit {
+			return p, append([]byte{}, key...), nil
+		}
+		p.Put(key, value)
+		size += len(key) + len(value)
+	}
+	return p, nil, iterator.Error()
+}
+
 func PrefixPatchValues(patch Patch, prefix []byte) Patch {
 	pa := &patchValuePrefixer{
 		prefix: prefix,
@@ -33,3 +33,37 @@ func TestRollbackPatch(t *testing.T) {
 040102 - DELETE
 071f - DELETE`)
 }
+
+// - the entries are split in parts of at least limit bytes, the next part starts at the returned key
+// - the deleted entries and the entries rejected by the filter are skipped
+func TestDumpDBFrom(t *testing.T) {
+	db := NewMemDB()
+	db.Put([]byte{1, 1}, []byte{10, 10})
+	db.Put([]byte{1, 2}, []byte{})
+	db.Put([]byte{2, 1}, []byte{11})
+	db.Put([]byte{2, 2}, []byte{12})
+	db.Put([]byte{3, 1}, []byte{13})
+	snapshot := db.Snapshot()
+	snapshot.Delete([]byte{2, 1})
+
+	p, next, err := DumpDBFrom(snapshot, nil, 5, nil)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, DebugPatch(p), `
+0101 - 0a0a
+0102 - `)
+	common.ExpectBytes(t, next, "0x0202")
+
+	p, next, err = DumpDBFrom(snapshot, next, 5, nil)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, DebugPatch(p), `
+0202 - 0c
+0301 - 0d`)
+	common.ExpectTrue(t, next == nil)
+
+	p, next, err = DumpDBFrom(snapshot, nil, 100, func(key []byte) bool { return key[1] == 1 })
+	common.FailIfErr(t, err)
+	common.ExpectString(t, DebugPatch(p), `
+0101 - 0a0a
+0301 - 0d`)
+	common.ExpectTrue(t, next == nil)
+}
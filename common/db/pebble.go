@@ -0,0 +1,393 @@
+package db
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/zenon-network/go-zenon/common"
+)
+
+// pebbleStorage implements rawStorage on a pebble DB.
+//
+// The versioned DB doesn't release the snapshots of the states it returns, so the open snapshots and iterators
+// are tracked and released by the garbage collector or when the DB is closed, like goleveldb does.
+type pebbleStorage struct {
+	db      *pebble.DB
+	mu      sync.RWMutex
+	closed  bool
+	readers map[io.Closer]struct{}
+}
+
+func newPebbleStorage(pdb *pebble.DB) *pebbleStorage {
+	return &pebbleStorage{
+		db:      pdb,
+		readers: map[io.Closer]struct{}{},
+	}
+}
+
+// pebbleLogger forwards the pebble logs to the chain logger
+type pebbleLogger struct {
+	log common.Logger
+}
+
+func (l *pebbleLogger) Infof(format string, args ...interface{}) {
+	l.log.Debug(fmt.Sprintf(format, args...))
+}
+func (l *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.log.Crit(fmt.Sprintf(format, args...))
+	panic(fmt.Sprintf(format, args...))
+}
+
+func openPebble(dir string, errorIfExists bool) (*pebble.DB, error) {
+	return pebble.Open(dir, &pebble.Options{
+		ErrorIfExists: errorIfExists,
+		Logger:        &pebbleLogger{log: common.ChainLogger.New("submodule", "pebble")},
+	})
+}
+
+func NewPebbleManager(dir string) Manager {
+	pdb, err := openPebble(dir, false)
+	common.DealWithErr(err)
+	return newRawStorageManager(dir, newPebbleStorage(pdb))
+}
+
+// acquire read-locks the storage. Fails if the storage is closed or if reader is not nil and was released.
+// The caller must RUnlock the storage if acquire succeeds.
+func (s *pebbleStorage) acquire(reader io.Closer) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return pebble.ErrClosed
+	}
+	if reader != nil {
+		if _, ok := s.readers[reader]; !ok {
+			s.mu.RUnlock()
+			return pebble.ErrClosed
+		}
+	}
+	return nil
+}
+
+// open creates a reader of parent, or of the storage if parent is nil, and tracks it
+func (s *pebbleStorage) open(parent io.Closer, create func() (io.Closer, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return pebble.ErrClosed
+	}
+	if parent != nil {
+		if _, ok := s.readers[parent]; !ok {
+			return pebble.ErrClosed
+		}
+	}
+	reader, err := create()
+	if err != nil {
+		return err
+	}
+	s.readers[reader] = struct{}{}
+	return nil
+}
+func (s *pebbleStorage) release(reader io.Closer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.readers[reader]; !ok {
+		return
+	}
+	delete(s.readers, reader)
+	// the error of an iterator was already reported by Error
+	_ = reader.Close()
+}
+
+func pebbleGet(get func([]byte) ([]byte, io.Closer, error), key []byte) ([]byte, error) {
+	value, closer, err := get(key)
+	if err == pebble.ErrNotFound {
+		return nil, leveldb.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return common.JoinBytes(value), nil
+}
+func pebbleWriteOptions(wo *opt.WriteOptions) *pebble.WriteOptions {
+	if wo != nil && wo.Sync {
+		return pebble.Sync
+	}
+	return pebble.NoSync
+}
+func pebbleIterOptions(slice *util.Range) *pebble.IterOptions {
+	if slice == nil {
+		return nil
+	}
+	return &pebble.IterOptions{
+		LowerBound: slice.Start,
+		UpperBound: slice.Limit,
+	}
+}
+
+func (s *pebbleStorage) Get(key []byte, _ *opt.ReadOptions) ([]byte, error) {
+	if err := s.acquire(nil); err != nil {
+		return nil, err
+	}
+	defer s.mu.RUnlock()
+	return pebbleGet(s.db.Get, key)
+}
+func (s *pebbleStorage) Has(key []byte, ro *opt.ReadOptions) (bool, error) {
+	_, err := s.Get(key, ro)
+	if err == leveldb.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+func (s *pebbleStorage) NewIterator(slice *util.Range, _ *opt.ReadOptions) iterator.Iterator {
+	var it *pebble.Iterator
+	if err := s.open(nil, func() (io.Closer, error) {
+		var err error
+		it, err = s.db.NewIter(pebbleIterOptions(slice))
+		return it, err
+	}); err != nil {
+		return iterator.NewEmptyIterator(err)
+	}
+	return newPebbleIterator(s, nil, it)
+}
+func (s *pebbleStorage) Put(key, value []byte, wo *opt.WriteOptions) error {
+	if err := s.acquire(nil); err != nil {
+		return err
+	}
+	defer s.mu.RUnlock()
+	return s.db.Set(key, value, pebbleWriteOptions(wo))
+}
+func (s *pebbleStorage) Delete(key []byte, wo *opt.WriteOptions) error {
+	if err := s.acquire(nil); err != nil {
+		return err
+	}
+	defer s.mu.RUnlock()
+	return s.db.Delete(key, pebbleWriteOptions(wo))
+}
+func (s *pebbleStorage) Write(batch *leveldb.Batch, wo *opt.WriteOptions) error {
+	if err := s.acquire(nil); err != nil {
+		return err
+	}
+	defer s.mu.RUnlock()
+	pb := s.db.NewBatch()
+	defer pb.Close()
+	replay := &pebbleBatchReplay{batch: pb}
+	if err := batch.Replay(replay); err != nil {
+		return err
+	}
+	if replay.err != nil {
+		return replay.err
+	}
+	return pb.Commit(pebbleWriteOptions(wo))
+}
+func (s *pebbleStorage) GetRawSnapshot() (rawSnapshot, error) {
+	snapshot := &pebbleSnapshot{
+		storage: s,
+	}
+	if err := s.open(nil, func() (io.Closer, error) {
+		snapshot.snapshot = s.db.NewSnapshot()
+		return snapshot.snapshot, nil
+	}); err != nil {
+		return nil, err
+	}
+	runtime.SetFinalizer(snapshot, (*pebbleSnapshot).Release)
+	return snapshot, nil
+}
+func (s *pebbleStorage) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return pebble.ErrClosed
+	}
+	s.closed = true
+	// the iterators must be closed before the snapshots they read
+	for reader := range s.readers {
+		if _, ok := reader.(*pebble.Iterator); ok {
+			_ = reader.Close()
+			delete(s.readers, reader)
+		}
+	}
+	for reader := range s.readers {
+		_ = reader.Close()
+		delete(s.readers, reader)
+	}
+	return s.db.Close()
+}
+
+type pebbleBatchReplay struct {
+	batch *pebble.Batch
+	err   error
+}
+
+func (r *pebbleBatchReplay) Put(key, value []byte) {
+	if r.err == nil {
+		r.err = r.batch.Set(key, value, nil)
+	}
+}
+func (r *pebbleBatchReplay) Delete(key []byte) {
+	if r.err == nil {
+		r.err = r.batch.Delete(key, nil)
+	}
+}
+
+type pebbleSnapshot struct {
+	storage  *pebbleStorage
+	snapshot *pebble.Snapshot
+}
+
+func (s *pebbleSnapshot) Get(key []byte, _ *opt.ReadOptions) ([]byte, error) {
+	if err := s.storage.acquire(s.snapshot); err != nil {
+		return nil, err
+	}
+	defer s.storage.mu.RUnlock()
+	return pebbleGet(s.snapshot.Get, key)
+}
+func (s *pebbleSnapshot) Has(key []byte, ro *opt.ReadOptions) (bool, error) {
+	_, err := s.Get(key, ro)
+	if err == leveldb.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+func (s *pebbleSnapshot) NewIterator(slice *util.Range, _ *opt.ReadOptions) iterator.Iterator {
+	var it *pebble.Iterator
+	if err := s.storage.open(s.snapshot, func() (io.Closer, error) {
+		var err error
+		it, err = s.snapshot.NewIter(pebbleIterOptions(slice))
+		return it, err
+	}); err != nil {
+		return iterator.NewEmptyIterator(err)
+	}
+	return newPebbleIterator(s.storage, s, it)
+}
+func (s *pebbleSnapshot) Release() {
+	s.storage.release(s.snapshot)
+}
+
+// pebbleIterator implements the goleveldb iterator on a pebble iterator
+type pebbleIterator struct {
+	storage *pebbleStorage
+	// keeps the snapshot alive while the iterator is used
+	snapshot   *pebbleSnapshot
+	it         *pebble.Iterator
+	positioned bool
+	err        error
+	releaser   util.Releaser
+}
+
+func newPebbleIterator(storage *pebbleStorage, snapshot *pebbleSnapshot, it *pebble.Iterator) *pebbleIterator {
+	i := &pebbleIterator{
+		storage:  storage,
+		snapshot: snapshot,
+		it:       it,
+	}
+	runtime.SetFinalizer(i, (*pebbleIterator).Release)
+	return i
+}
+
+func (i *pebbleIterator) do(f func() bool) bool {
+	if i.err != nil {
+		return false
+	}
+	if err := i.storage.acquire(i.it); err != nil {
+		i.err = err
+		return false
+	}
+	defer i.storage.mu.RUnlock()
+	return f()
+}
+func (i *pebbleIterator) First() bool {
+	return i.do(func() bool {
+		i.positioned = true
+		return i.it.First()
+	})
+}
+func (i *pebbleIterator) Last() bool {
+	return i.do(func() bool {
+		i.positioned = true
+		return i.it.Last()
+	})
+}
+func (i *pebbleIterator) Seek(key []byte) bool {
+	return i.do(func() bool {
+		i.positioned = true
+		return i.it.SeekGE(key)
+	})
+}
+func (i *pebbleIterator) Next() bool {
+	return i.do(func() bool {
+		if !i.positioned {
+			i.positioned = true
+			return i.it.First()
+		}
+		return i.it.Next()
+	})
+}
+func (i *pebbleIterator) Prev() bool {
+	return i.do(func() bool {
+		if !i.positioned {
+			i.positioned = true
+			return i.it.Last()
+		}
+		return i.it.Prev()
+	})
+}
+func (i *pebbleIterator) Valid() bool {
+	return i.do(func() bool {
+		return i.positioned && i.it.Valid()
+	})
+}
+func (i *pebbleIterator) Key() []byte {
+	var key []byte
+	i.do(func() bool {
+		if i.positioned && i.it.Valid() {
+			key = i.it.Key()
+		}
+		return true
+	})
+	return key
+}
+func (i *pebbleIterator) Value() []byte {
+	var value []byte
+	i.do(func() bool {
+		if i.positioned && i.it.Valid() {
+			value = i.it.Value()
+		}
+		return true
+	})
+	return value
+}
+func (i *pebbleIterator) Error() error {
+	if i.err != nil {
+		return i.err
+	}
+	var err error
+	i.do(func() bool {
+		err = i.it.Error()
+		return true
+	})
+	if i.err != nil {
+		return i.err
+	}
+	return err
+}
+func (i *pebbleIterator) Release() {
+	i.storage.release(i.it)
+	if i.err == nil {
+		i.err = iterator.ErrIterReleased
+	}
+	if i.releaser != nil {
+		i.releaser.Release()
+		i.releaser = nil
+	}
+}
+func (i *pebbleIterator) SetReleaser(releaser util.Releaser) {
+	i.releaser = releaser
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"path"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func addMockTransactions(m Manager, count int64) []types.HashHeight {
+	identifiers := make([]types.HashHeight, 0)
+	for i := int64(1); i <= count; i += 1 {
+		transaction := newMockTransaction(i, m.Frontier())
+		common.DealWithErr(m.Add(transaction))
+		identifiers = append(identifiers, transaction.commit.Identifier())
+	}
+	return identifiers
+}
+
+// - the pebble versioned DB has the same states as the leveldb one
+// - the states are kept after a restart and can be rolled back and pruned
+func TestPebbleManager(t *testing.T) {
+	ldbManager := NewLevelDBManager(t.TempDir())
+	defer ldbManager.Stop()
+	dir := t.TempDir()
+	m := NewPebbleManager(dir)
+
+	addMockTransactions(ldbManager, 6)
+	identifiers := addMockTransactions(m, 6)
+	common.ExpectString(t, DebugDB(m.Frontier()), DebugDB(ldbManager.Frontier()))
+	for _, identifier := range identifiers {
+		common.ExpectString(t, DebugDB(m.Get(identifier)), DebugDB(ldbManager.Get(identifier)))
+		common.ExpectString(t, DebugPatch(m.GetPatch(identifier)), DebugPatch(ldbManager.GetPatch(identifier)))
+	}
+
+	common.FailIfErr(t, m.Stop())
+	m = NewPebbleManager(dir)
+	common.ExpectString(t, DebugDB(m.Frontier()), DebugDB(ldbManager.Frontier()))
+	common.FailIfErr(t, m.Pop())
+	common.ExpectString(t, DebugDB(m.Frontier()), DebugDB(ldbManager.Get(identifiers[4])))
+	common.FailIfErr(t, m.Prune(3, nil))
+	common.ExpectTrue(t, m.Get(identifiers[1]) == nil)
+	common.ExpectString(t, DebugDB(m.Get(identifiers[2])), DebugDB(ldbManager.Get(identifiers[2])))
+	common.FailIfErr(t, m.Stop())
+}
+
+// - the migrated DB has the same states as the leveldb one
+// - the migration doesn't overwrite an existing DB
+func TestMigrateLevelDBToPebble(t *testing.T) {
+	src := t.TempDir()
+	dst := path.Join(t.TempDir(), "nom-pebble")
+	ldbManager := NewLevelDBManager(src)
+	identifiers := addMockTransactions(ldbManager, 4)
+	expected := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		expected[i] = DebugDB(ldbManager.Get(identifier))
+	}
+	common.FailIfErr(t, ldbManager.Stop())
+
+	keys, err := MigrateLevelDBToPebble(src, dst, nil)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, keys, 37)
+
+	m := NewPebbleManager(dst)
+	for i, identifier := range identifiers {
+		common.ExpectString(t, DebugDB(m.Get(identifier)), expected[i])
+	}
+	common.FailIfErr(t, m.Stop())
+
+	_, err = MigrateLevelDBToPebble(src, dst, nil)
+	common.ExpectString(t, err.Error(), dst+" already exists")
+}
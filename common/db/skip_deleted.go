@@ -7,6 +7,9 @@ type skipDeletedDb struct {
 func (db *skipDeletedDb) NewIterator(prefix []byte) StorageIterator {
 	return newSkipDeletedIterator(db.db.NewIterator(prefix))
 }
+func (db *skipDeletedDb) newIteratorFrom(prefix []byte, start []byte) StorageIterator {
+	return newSkipDeletedIterator(db.db.newIteratorFrom(prefix, start))
+}
 
 type skipDeletedIterator struct {
 	StorageIterator
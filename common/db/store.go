@@ -17,6 +17,11 @@ func getEntryByHeightKey(height uint64) []byte {
 	return common.JoinBytes(entryByHeightPrefix, common.Uint64ToBytes(height))
 }
 
+// EntryByHeightKey returns the key of the entry at height, used to delete old entries
+func EntryByHeightKey(height uint64) []byte {
+	return getEntryByHeightKey(height)
+}
+
 func SetFrontier(db DB, version types.HashHeight, data []byte) error {
 	if err := db.Put(getFrontierIdentifierKey(), version.Serialize()); err != nil {
 		return err
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"runtime"
 	"sync"
 
@@ -17,12 +18,20 @@ const (
 	l1CacheSize                  = 400
 	l2CacheSize                  = 100
 	maximumCacheHeightDifference = 360
+	pruneBatchSize               = 10000
+	installBatchSize             = 10000
 )
 
 var (
 	frontierByte = []byte{85}
 	patchByte    = []byte{102}
 	rollbackByte = []byte{119}
+	prunedByte   = []byte{136}
+	// installingByte marks a state which is being installed, see ldbManager.Install
+	installingByte = []byte{153}
+
+	// ErrPruned is returned for the states deleted by the pruning mode
+	ErrPruned = errors.New("pruned: the node doesn't keep the history of this state")
 )
 
 func absDiff(x, y uint64) uint64 {
@@ -51,6 +60,16 @@ type Manager interface {
 	Add(Transaction) error
 	Pop() error
 
+	// PrunedHeight returns the height below which the states were pruned, 0 if the whole history is kept.
+	// Get and GetPatch return nil for the pruned heights and Pop fails once the frontier reaches it.
+	PrunedHeight() uint64
+	// Prune deletes the rollback data of the states below height and deletes keys from the frontier state.
+	// The deleted keys are not versioned, so the older states can't be restored.
+	Prune(height uint64, keys [][]byte) error
+	// Install replaces a state which has at most the genesis commit with the state of identifier.
+	// The history of the state is unknown, so the heights below identifier are reported as pruned.
+	Install(identifier types.HashHeight, state DB) error
+
 	Stop() error
 	Location() string
 }
@@ -62,6 +81,7 @@ type memdbManager struct {
 	previous           map[types.HashHeight]types.HashHeight
 	versions           map[types.HashHeight]DB
 	patches            map[types.HashHeight]Patch
+	prunedHeight       uint64
 
 	changes sync.Mutex
 }
@@ -158,6 +178,9 @@ func (m *memdbManager) Pop() error {
 		return errors.Errorf("can't rollback stable db")
 	}
 
+	if m.frontierIdentifier.Height <= m.prunedHeight {
+		return ErrPruned
+	}
 	previous, ok := m.previous[m.frontierIdentifier]
 	if !ok {
 		return errors.Errorf("can't find previous for ")
@@ -169,6 +192,72 @@ func (m *memdbManager) Pop() error {
 	m.frontierIdentifier = previous
 	return nil
 }
+func (m *memdbManager) PrunedHeight() uint64 {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	return m.prunedHeight
+}
+func (m *memdbManager) Prune(height uint64, keys [][]byte) error {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	if height > m.frontierIdentifier.Height {
+		return errors.Errorf("can't prune above the frontier %v", m.frontierIdentifier)
+	}
+
+	if height > m.prunedHeight {
+		for identifier := range m.versions {
+			if identifier.Height < height {
+				delete(m.previous, identifier)
+				delete(m.versions, identifier)
+				delete(m.patches, identifier)
+			}
+		}
+		m.prunedHeight = height
+	}
+	for _, version := range m.versions {
+		for _, key := range keys {
+			if err := version.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+func (m *memdbManager) Install(identifier types.HashHeight, state DB) error {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	if m.frontierIdentifier.Height > 1 {
+		return errors.Errorf("can't install a state over the frontier %v", m.frontierIdentifier)
+	}
+
+	installed := NewMemDB()
+	iterator := state.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		// deleted entries are still iterated, with a nil value
+		if iterator.Value() == nil {
+			continue
+		}
+		if err := installed.Put(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	if frontierIdentifier := GetFrontierIdentifier(installed); frontierIdentifier != identifier {
+		return errors.Errorf("can't install the state of %v as %v", frontierIdentifier, identifier)
+	}
+
+	m.stableDB = installed
+	m.stableIdentifier = identifier
+	m.frontierIdentifier = identifier
+	m.previous = map[types.HashHeight]types.HashHeight{}
+	m.versions = map[types.HashHeight]DB{identifier: installed}
+	m.patches = map[types.HashHeight]Patch{}
+	m.prunedHeight = identifier.Height
+	return nil
+}
 func (m *memdbManager) Stop() error {
 	m.frontierIdentifier = types.ZeroHashHeight
 	m.versions = nil
@@ -184,39 +273,113 @@ type rollbackCache struct {
 	raw      db
 }
 
+// rawStorage is the persistent key-value store of the versioned DB.
+// Missing keys are reported with leveldb.ErrNotFound by all the implementations.
+type rawStorage interface {
+	LevelDBLike
+	Delete(key []byte, wo *opt.WriteOptions) error
+	Write(batch *leveldb.Batch, wo *opt.WriteOptions) error
+	GetRawSnapshot() (rawSnapshot, error)
+	Close() error
+}
+
+type rawSnapshot interface {
+	LevelDBLikeRO
+	Release()
+}
+
+type levelDBStorage struct {
+	*leveldb.DB
+}
+
+func (s *levelDBStorage) GetRawSnapshot() (rawSnapshot, error) {
+	return s.GetSnapshot()
+}
+
 type ldbManager struct {
 	location string
 	l1Cache  *lru.Cache
 	l2Cache  *lru.Cache
-	ldb      *leveldb.DB
+	ldb      rawStorage
 	changes  sync.Mutex
 	stopped  bool
+
+	prunedHeight uint64
 }
 
 func NewLevelDBManager(dir string) Manager {
 	opts := &opt.Options{OpenFilesCacheCapacity: getOpenFilesCacheCapacity()}
 	ldb, err := leveldb.OpenFile(dir, opts)
 	common.DealWithErr(err)
+	return newRawStorageManager(dir, &levelDBStorage{DB: ldb})
+}
+
+func newRawStorageManager(dir string, ldb rawStorage) Manager {
 	l1Cache, err := lru.New(l1CacheSize)
 	common.DealWithErr(err)
 	l2Cache, err := lru.New(l2CacheSize)
 	common.DealWithErr(err)
+
+	// an interrupted installation leaves a partial state, which is deleted so that the chain starts again from the genesis
+	if _, err := ldb.Get(installingByte, nil); err == nil {
+		common.DealWithErr(clearRawStorage(ldb))
+	} else if err != leveldb.ErrNotFound {
+		common.DealWithErr(err)
+	}
+
+	var prunedHeight uint64
+	if value, err := ldb.Get(prunedByte, nil); err == nil {
+		prunedHeight = common.BytesToUint64(value)
+	} else if err != leveldb.ErrNotFound {
+		common.DealWithErr(err)
+	}
 	return &ldbManager{
-		location: dir,
-		l1Cache:  l1Cache,
-		l2Cache:  l2Cache,
-		ldb:      ldb,
+		location:     dir,
+		l1Cache:      l1Cache,
+		l2Cache:      l2Cache,
+		ldb:          ldb,
+		prunedHeight: prunedHeight,
 	}
 }
 
+// clearRawStorage deletes all the data of ldb, in bounded batches
+func clearRawStorage(ldb rawStorage) error {
+	snapshot, err := ldb.GetRawSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	batch := new(leveldb.Batch)
+	iterator := snapshot.NewIterator(nil, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		// the marker of the interrupted installation is deleted last
+		if bytes.Equal(iterator.Key(), installingByte) {
+			continue
+		}
+		batch.Delete(iterator.Key())
+		if batch.Len() >= installBatchSize {
+			if err := ldb.Write(batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	batch.Delete(installingByte)
+	return ldb.Write(batch, nil)
+}
+
 func (m *ldbManager) Frontier() DB {
 	m.changes.Lock()
 	defer m.changes.Unlock()
 	if m.stopped {
 		return nil
 	}
-	snapshot, _ := m.ldb.GetSnapshot()
-	return NewLevelDBSnapshotWrapper(snapshot).Subset(frontierByte)
+	snapshot, _ := m.ldb.GetRawSnapshot()
+	return newLevelDBROWrapper(snapshot).Subset(frontierByte)
 }
 func (m *ldbManager) Get(identifier types.HashHeight) DB {
 	m.changes.Lock()
@@ -224,9 +387,9 @@ func (m *ldbManager) Get(identifier types.HashHeight) DB {
 	if m.stopped {
 		return nil
 	}
-	snapshot, _ := m.ldb.GetSnapshot()
+	snapshot, _ := m.ldb.GetRawSnapshot()
 	// check if has snapshot
-	frontier := NewLevelDBSnapshotWrapper(snapshot).Subset(frontierByte)
+	frontier := newLevelDBROWrapper(snapshot).Subset(frontierByte)
 	frontierIdentifier := GetFrontierIdentifier(frontier)
 
 	if identifier.IsZero() {
@@ -235,6 +398,9 @@ func (m *ldbManager) Get(identifier types.HashHeight) DB {
 	if identifier == frontierIdentifier {
 		return frontier
 	}
+	if identifier.Height < m.prunedHeight {
+		return nil
+	}
 
 	trueIdentifier, err := GetIdentifierByHash(frontier, identifier.Hash)
 	if err == leveldb.ErrNotFound {
@@ -283,7 +449,7 @@ func (m *ldbManager) Get(identifier types.HashHeight) DB {
 		newSkipDelete(
 			newMergedDb([]db{
 				rawChanges,
-				newSubDB(frontierByte, newLevelDBSnapshotWrapper(snapshot)),
+				newSubDB(frontierByte, newLevelDBROSnapshot(snapshot)),
 			})),
 	})
 	return enableDelete(u)
@@ -291,13 +457,14 @@ func (m *ldbManager) Get(identifier types.HashHeight) DB {
 func (m *ldbManager) GetPatch(identifier types.HashHeight) Patch {
 	m.changes.Lock()
 	defer m.changes.Unlock()
-	if m.stopped {
+	if m.stopped || identifier.Height < m.prunedHeight {
 		return nil
 	}
 	return m.getPatch(identifier)
 }
 func (m *ldbManager) getPatch(identifier types.HashHeight) Patch {
-	snapshot, _ := m.ldb.GetSnapshot()
+	snapshot, _ := m.ldb.GetRawSnapshot()
+	defer snapshot.Release()
 	value, err := snapshot.Get(common.JoinBytes(patchByte, common.Uint64ToBytes(identifier.Height)), nil)
 	if err == leveldb.ErrNotFound {
 		return nil
@@ -309,7 +476,8 @@ func (m *ldbManager) getPatch(identifier types.HashHeight) Patch {
 	return patch
 }
 func (m *ldbManager) getRollback(height uint64) Patch {
-	snapshot, _ := m.ldb.GetSnapshot()
+	snapshot, _ := m.ldb.GetRawSnapshot()
+	defer snapshot.Release()
 	value, err := snapshot.Get(common.JoinBytes(rollbackByte, common.Uint64ToBytes(height)), nil)
 	if err == leveldb.ErrNotFound {
 		return nil
@@ -367,7 +535,7 @@ func (m *ldbManager) Add(transaction Transaction) error {
 		if err := m.ldb.Put(common.JoinBytes(rollbackByte, common.Uint64ToBytes(identifier.Height)), rollbackPatch.Dump(), nil); err != nil {
 			return err
 		}
-		if err := ApplyPatch(NewLevelDBWrapper(m.ldb).Subset(frontierByte), patch); err != nil {
+		if err := ApplyPatch(newLevelDBLikeWrapper(m.ldb).Subset(frontierByte), patch); err != nil {
 			return err
 		}
 	}
@@ -375,9 +543,12 @@ func (m *ldbManager) Add(transaction Transaction) error {
 }
 func (m *ldbManager) Pop() error {
 	frontierIdentifier := GetFrontierIdentifier(m.Frontier())
+	if frontierIdentifier.Height <= m.PrunedHeight() {
+		return ErrPruned
+	}
 	rollbackPatch := m.getRollback(frontierIdentifier.Height)
 
-	if err := ApplyPatch(NewLevelDBWrapper(m.ldb).Subset(frontierByte), rollbackPatch); err != nil {
+	if err := ApplyPatch(newLevelDBLikeWrapper(m.ldb).Subset(frontierByte), rollbackPatch); err != nil {
 		return err
 	}
 	if err := m.ldb.Delete(common.JoinBytes(patchByte, common.Uint64ToBytes(frontierIdentifier.Height)), nil); err != nil {
@@ -389,6 +560,137 @@ func (m *ldbManager) Pop() error {
 
 	return nil
 }
+func (m *ldbManager) PrunedHeight() uint64 {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	return m.prunedHeight
+}
+func (m *ldbManager) Prune(height uint64, keys [][]byte) error {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	if m.stopped {
+		return errors.Errorf("can't prune a stopped db")
+	}
+	snapshot, err := m.ldb.GetRawSnapshot()
+	if err != nil {
+		return err
+	}
+	frontierIdentifier := GetFrontierIdentifier(newLevelDBROWrapper(snapshot).Subset(frontierByte))
+	snapshot.Release()
+	if height > frontierIdentifier.Height {
+		return errors.Errorf("can't prune above the frontier %v", frontierIdentifier)
+	}
+
+	batch := new(leveldb.Batch)
+	flush := func() error {
+		if err := m.ldb.Write(batch, nil); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	}
+
+	if height > m.prunedHeight {
+		// the pruned height is persisted before deleting anything, so a crash in between leaves unused patches
+		// instead of heights reported as available without their patches
+		if err := m.ldb.Put(prunedByte, common.Uint64ToBytes(height), nil); err != nil {
+			return err
+		}
+		previous := m.prunedHeight
+		m.prunedHeight = height
+
+		// the rollback of height h restores the state h-1, so the rollbacks up to height are useless once the states below it are pruned
+		for h := previous + 1; h <= height; h += 1 {
+			batch.Delete(common.JoinBytes(patchByte, common.Uint64ToBytes(h)))
+			batch.Delete(common.JoinBytes(rollbackByte, common.Uint64ToBytes(h)))
+			if batch.Len() >= pruneBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, key := range keys {
+		batch.Delete(common.JoinBytes(frontierByte, key))
+	}
+	return flush()
+}
+
+func (m *ldbManager) Install(identifier types.HashHeight, state DB) error {
+	m.changes.Lock()
+	defer m.changes.Unlock()
+	if m.stopped {
+		return errors.Errorf("can't install a state in a stopped db")
+	}
+	if frontierIdentifier := GetFrontierIdentifier(state); frontierIdentifier != identifier {
+		return errors.Errorf("can't install the state of %v as %v", frontierIdentifier, identifier)
+	}
+	snapshot, err := m.ldb.GetRawSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	frontierIdentifier := GetFrontierIdentifier(newLevelDBROWrapper(snapshot).Subset(frontierByte))
+	if frontierIdentifier.Height > 1 {
+		return errors.Errorf("can't install a state over the frontier %v", frontierIdentifier)
+	}
+
+	// the installed state replaces all the data. It's written in bounded batches, since it doesn't fit in memory,
+	// after installingByte which marks the partial states left by a crash, see newRawStorageManager
+	if err := m.ldb.Put(installingByte, common.Uint64ToBytes(identifier.Height), nil); err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	flush := func() error {
+		if err := m.ldb.Write(batch, nil); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	}
+	rawIterator := snapshot.NewIterator(nil, nil)
+	for rawIterator.Next() {
+		batch.Delete(rawIterator.Key())
+		if batch.Len() >= installBatchSize {
+			if err := flush(); err != nil {
+				rawIterator.Release()
+				return err
+			}
+		}
+	}
+	rawIterator.Release()
+	if err := rawIterator.Error(); err != nil {
+		return err
+	}
+	iterator := state.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		// deleted entries are still iterated, with a nil value
+		if iterator.Value() == nil {
+			continue
+		}
+		// the frontier state is written by enableDeleteDB, which marks the existing values with existsByte
+		batch.Put(common.JoinBytes(frontierByte, iterator.Key()), common.JoinBytes(existsByte, iterator.Value()))
+		if batch.Len() >= installBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	batch.Put(prunedByte, common.Uint64ToBytes(identifier.Height))
+	batch.Delete(installingByte)
+	if err := flush(); err != nil {
+		return err
+	}
+
+	m.prunedHeight = identifier.Height
+	m.l1Cache.Purge()
+	m.l2Cache.Purge()
+	return nil
+}
 func (m *ldbManager) Stop() error {
 	m.changes.Lock()
 	defer m.changes.Unlock()
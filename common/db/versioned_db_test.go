@@ -260,3 +260,92 @@ d5104dc76695721d - b80704bb7b4d7c03
 dc2864602be7fb85 - d38967f931a50490
 f25f4b21eef64b43 - 9c0a8a2bfc0914df`)
 }
+
+// - the states below the pruned height can't be read or rolled back to
+// - the pruned height is kept after a restart
+func TestVersionedDBPrune(t *testing.T) {
+	dir := t.TempDir()
+	m := NewLevelDBManager(dir)
+
+	identifiers := make([]types.HashHeight, 0)
+	for i := int64(1); i <= 6; i += 1 {
+		transaction := newMockTransaction(i, m.Frontier())
+		common.DealWithErr(m.Add(transaction))
+		identifiers = append(identifiers, transaction.commit.Identifier())
+	}
+	common.ExpectUint64(t, m.PrunedHeight(), 0)
+
+	common.FailIfErr(t, m.Prune(4, nil))
+	common.ExpectUint64(t, m.PrunedHeight(), 4)
+	common.ExpectTrue(t, m.Get(identifiers[2]) == nil)
+	common.ExpectTrue(t, m.GetPatch(identifiers[2]) == nil)
+	common.ExpectTrue(t, m.Get(identifiers[3]) != nil)
+	common.ExpectTrue(t, m.Get(identifiers[4]) != nil)
+
+	common.FailIfErr(t, m.Pop())
+	common.FailIfErr(t, m.Pop())
+	common.ExpectTrue(t, GetFrontierIdentifier(m.Frontier()) == identifiers[3])
+	common.ExpectError(t, m.Pop(), ErrPruned)
+
+	common.FailIfErr(t, m.Stop())
+	m2 := NewLevelDBManager(dir)
+	defer m2.Stop()
+	common.ExpectUint64(t, m2.PrunedHeight(), 4)
+	common.ExpectTrue(t, m2.Get(identifiers[2]) == nil)
+	common.ExpectError(t, m2.Pop(), ErrPruned)
+}
+
+// - the installed state replaces the genesis state and is kept after a restart
+// - the heights below the installed state are reported as pruned
+// - a state can't be installed over more than the genesis commit
+func TestVersionedDBInstall(t *testing.T) {
+	source := NewLevelDBManager(t.TempDir())
+	defer source.Stop()
+	identifiers := make([]types.HashHeight, 0)
+	for i := int64(1); i <= 4; i += 1 {
+		transaction := newMockTransaction(i, source.Frontier())
+		common.DealWithErr(source.Add(transaction))
+		identifiers = append(identifiers, transaction.commit.Identifier())
+	}
+	state := source.Frontier()
+
+	dir := t.TempDir()
+	m := NewLevelDBManager(dir)
+	common.DealWithErr(m.Add(newMockTransaction(10, m.Frontier())))
+	common.ExpectTrue(t, m.Install(identifiers[2], state) != nil)
+	common.FailIfErr(t, m.Install(identifiers[3], state))
+	common.ExpectString(t, DebugDB(m.Frontier()), DebugDB(source.Frontier()))
+	common.ExpectUint64(t, m.PrunedHeight(), 4)
+	common.ExpectTrue(t, m.Get(identifiers[2]) == nil)
+	common.ExpectError(t, m.Pop(), ErrPruned)
+
+	common.DealWithErr(m.Add(newMockTransaction(5, m.Frontier())))
+	common.ExpectString(t, DebugDB(m.Get(identifiers[3])), DebugDB(source.Frontier()))
+	common.ExpectTrue(t, m.Install(identifiers[3], state) != nil)
+
+	common.FailIfErr(t, m.Stop())
+	m2 := NewLevelDBManager(dir)
+	defer m2.Stop()
+	common.ExpectUint64(t, m2.PrunedHeight(), 4)
+	common.ExpectTrue(t, m2.Get(identifiers[2]) == nil)
+	common.ExpectUint64(t, GetFrontierIdentifier(m2.Frontier()).Height, 5)
+}
+
+// - the partial state left by an interrupted installation is deleted when the db is opened
+func TestVersionedDBInterruptedInstall(t *testing.T) {
+	dir := t.TempDir()
+	m := NewLevelDBManager(dir)
+	for i := int64(1); i <= 3; i += 1 {
+		common.DealWithErr(m.Add(newMockTransaction(i, m.Frontier())))
+	}
+	common.FailIfErr(t, m.(*ldbManager).ldb.Put(installingByte, common.Uint64ToBytes(3), nil))
+	common.FailIfErr(t, m.(*ldbManager).ldb.Put(prunedByte, common.Uint64ToBytes(3), nil))
+	common.FailIfErr(t, m.Stop())
+
+	m2 := NewLevelDBManager(dir)
+	defer m2.Stop()
+	common.ExpectUint64(t, m2.PrunedHeight(), 0)
+	common.ExpectTrue(t, GetFrontierIdentifier(m2.Frontier()).IsZero())
+	common.DealWithErr(m2.Add(newMockTransaction(1, m2.Frontier())))
+	common.ExpectUint64(t, GetFrontierIdentifier(m2.Frontier()).Height, 1)
+}
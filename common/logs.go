@@ -23,6 +23,7 @@ var (
 	DownloaderLogger = ProtocolLogger.New("submodule", "downloader")
 	RPCLogger        = log15.New("module", "rpc")
 	VerifierLogger   = log15.New("module", "verifier")
+	IndexerLogger    = log15.New("module", "indexer")
 	ZenonLogger      = log15.New("module", "zenon")
 	VmLogger         = log15.New("module", "vm")
 	SupervisorLogger = log15.New("module", "supervisor")
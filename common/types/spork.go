@@ -5,12 +5,15 @@ var (
 	HtlcSpork               = NewImplementedSpork("ceb7e3808ef17ea910adda2f3ab547be4cdfb54de8400ce3683258d06be1354b")
 	BridgeAndLiquiditySpork = NewImplementedSpork("ddd43466769461c5b5d109c639da0f50a7eeb96ad6e7274b1928a35c431d7b1b")
 	NoPillarRegSpork        = NewImplementedSpork("c35c80695e6f1739ce19bd9b31e4a6702335fafd643139eb73b76541be2ca9e4")
+	// ConsensusParametersSpork allows the spork address to create sporks which change the consensus parameters
+	ConsensusParametersSpork = NewImplementedSpork("fba84fe712775e8a6381ec523a3d3a95fc61c5ffd48c0355aa67958eb703a245")
 
 	ImplementedSporksMap = map[Hash]bool{
-		AcceleratorSpork.SporkId:        true,
-		HtlcSpork.SporkId:               true,
-		BridgeAndLiquiditySpork.SporkId: true,
-		NoPillarRegSpork.SporkId:        true,
+		AcceleratorSpork.SporkId:         true,
+		HtlcSpork.SporkId:                true,
+		BridgeAndLiquiditySpork.SporkId:  true,
+		NoPillarRegSpork.SporkId:         true,
+		ConsensusParametersSpork.SporkId: true,
 	}
 )
 
@@ -47,9 +47,11 @@ func (obj *API) EpochStats(epoch uint64) (*api.EpochStats, error) {
 		return nil, nil
 	}
 
+	sTime, _ := obj.EpochTicker().ToTime(epoch)
 	stats := &api.EpochStats{
 		Pillars:     make(map[string]*api.EpochPillarStats),
 		Epoch:       epoch,
+		BlockTime:   obj.er.ContextByTick(obj.er.ToTick(sTime)).BlockTime,
 		TotalWeight: point.TotalWeight,
 	}
 	for pillarName, v := range point.Pillars {
@@ -64,13 +66,13 @@ func (obj *API) EpochStats(epoch uint64) (*api.EpochStats, error) {
 	return stats, nil
 }
 func (obj *API) GetPillarDelegationsByEpoch(epoch uint64) (map[string]*types.PillarDelegationDetail, error) {
-	multiplier, err := obj.er.TickMultiplier(obj.EpochTicker())
-	common.DealWithErr(err)
+	sTime, eTime := obj.EpochTicker().ToTime(epoch)
+	start, end := obj.er.ToTick(sTime), obj.er.ToTick(eTime)
 
 	result := make(map[string]*types.PillarDelegationDetail, 0)
 
-	for i := uint64(0); i < multiplier; i += 1 {
-		current, err := obj.er.DelegationsByTick(i + (epoch)*multiplier)
+	for i := start; i < end; i += 1 {
+		current, err := obj.er.DelegationsByTick(i)
 		if err != nil {
 			return nil, err
 		}
@@ -87,7 +89,7 @@ func (obj *API) GetPillarDelegationsByEpoch(epoch uint64) (map[string]*types.Pil
 	}
 
 	for _, detail := range result {
-		detail.Reduce(int64(multiplier))
+		detail.Reduce(int64(end - start))
 	}
 	return result, nil
 }
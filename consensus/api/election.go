@@ -0,0 +1,53 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+type ProducerSlot struct {
+	Name      string        `json:"name"`
+	Producer  types.Address `json:"producer"`
+	StartTime int64         `json:"startTime"`
+	EndTime   int64         `json:"endTime"`
+}
+
+type DelegationSnapshot struct {
+	Name     string        `json:"name"`
+	Producer types.Address `json:"producer"`
+	Weight   *big.Int      `json:"weight"`
+}
+
+type ElectionSchedule struct {
+	Tick      uint64 `json:"tick"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+	// Algorithm is the name of the election algorithm used by the chain
+	Algorithm string `json:"algorithm"`
+	// ProofMomentum is the last momentum before the end of tick-2, which seeds the election
+	ProofMomentum types.HashHeight      `json:"proofMomentum"`
+	Producers     []*ProducerSlot       `json:"producers"`
+	Delegations   []*DelegationSnapshot `json:"delegations"`
+}
+
+// ConsensusParameters are the consensus parameters used by the election ticks since StartTick
+type ConsensusParameters struct {
+	StartTick uint64 `json:"startTick"`
+	StartTime int64  `json:"startTime"`
+	BlockTime int64  `json:"blockTime"`
+	NodeCount uint8  `json:"nodeCount"`
+	RandCount uint8  `json:"randCount"`
+	// Sporks which changed the parameters at StartTime, empty for the genesis parameters
+	Sporks []types.Hash `json:"sporks"`
+}
+
+type ElectionScheduleReader interface {
+	// CurrentTick returns the election tick of the current time
+	CurrentTick() (uint64, error)
+	// ElectionByTick returns the election of a past, the current or the next tick.
+	// Later ticks can't be computed since their proof momentum doesn't exist yet.
+	ElectionByTick(tick uint64) (*ElectionSchedule, error)
+	// ConsensusParameters returns the genesis parameters followed by the changes made by consensus sporks
+	ConsensusParameters() []*ConsensusParameters
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+type SlotRecord struct {
+	Tick      uint64        `json:"tick"`
+	Name      string        `json:"name"`
+	Producer  types.Address `json:"producer"`
+	StartTime int64         `json:"startTime"`
+	EndTime   int64         `json:"endTime"`
+	Produced  bool          `json:"produced"`
+	// Momentum is the momentum produced in the slot, nil if the slot was missed
+	Momentum *types.HashHeight `json:"momentum"`
+}
+
+type PillarLiveness struct {
+	Name         string `json:"name"`
+	ScheduledNum uint64 `json:"scheduledNum"`
+	ProducedNum  uint64 `json:"producedNum"`
+	MissedNum    uint64 `json:"missedNum"`
+	// ConsecutiveMissed is the number of slots missed since the last momentum produced by the pillar
+	ConsecutiveMissed uint64 `json:"consecutiveMissed"`
+	// LastProducedTime is the start time of the last slot produced in the window, 0 if none
+	LastProducedTime int64 `json:"lastProducedTime"`
+}
+
+type LivenessWindow struct {
+	FromTick uint64            `json:"fromTick"`
+	ToTick   uint64            `json:"toTick"`
+	Pillars  []*PillarLiveness `json:"pillars"`
+}
+
+type MissedSlot struct {
+	Slot              *SlotRecord `json:"slot"`
+	ConsecutiveMissed uint64      `json:"consecutiveMissed"`
+}
+
+type LivenessReader interface {
+	// SlotsByTick returns the slots of an election tick which are decided by the frontier momentum.
+	// A slot is decided once the frontier momentum is not older than the start of the slot.
+	SlotsByTick(tick uint64) ([]*SlotRecord, error)
+	// LivenessWindow returns the liveness of the pillars elected in the last LivenessWindowTicks ticks
+	LivenessWindow() (*LivenessWindow, error)
+}
@@ -16,7 +16,9 @@ type EpochPillarStats struct {
 }
 
 type EpochStats struct {
-	Epoch       uint64                       `json:"epoch"`
+	Epoch uint64 `json:"epoch"`
+	// BlockTime is the block time in seconds during the epoch, consensus sporks change it only between epochs
+	BlockTime   int64                        `json:"blockTime"`
 	Pillars     map[string]*EpochPillarStats `json:"pillars"`
 	TotalWeight *big.Int                     `json:"totalWeight"`
 	// Total number of blocks generated in an epoch
@@ -13,7 +13,6 @@ import (
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus/api"
 	"github.com/zenon-network/go-zenon/consensus/storage"
-	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 var (
@@ -24,11 +23,13 @@ type consensus struct {
 	log     common.Logger
 	genesis time.Time
 	chain   chain.Chain
+	db      db.DB
 	testing bool
 
 	*eventManager
 	electionManager *electionManager
 	points          Points
+	liveness        *liveness
 
 	wg     sync.WaitGroup
 	closed chan struct{}
@@ -42,30 +43,51 @@ func (cs *consensus) FrontierPillarReader() api.PillarReader {
 	}
 }
 func (cs *consensus) FixedPillarReader(identifier types.HashHeight) api.PillarReader {
+	momentumStore, err := cs.chain.GetMomentumStore(identifier)
+	if err != nil || momentumStore == nil {
+		return nil
+	}
 	return &API{
-		momentumStore: cs.chain.GetMomentumStore(identifier),
+		momentumStore: momentumStore,
 		er:            cs.electionManager,
 		points:        cs.points,
 	}
 }
+func (cs *consensus) ElectionScheduleReader() api.ElectionScheduleReader {
+	return &scheduleReader{
+		em: cs.electionManager,
+	}
+}
+func (cs *consensus) LivenessReader() api.LivenessReader {
+	return cs.liveness
+}
+func (cs *consensus) RegisterMissedSlotListener(listener MissedSlotListener) {
+	cs.liveness.RegisterMissedSlotListener(listener)
+}
+func (cs *consensus) UnRegisterMissedSlotListener(listener MissedSlotListener) {
+	cs.liveness.UnRegisterMissedSlotListener(listener)
+}
 
 // NewConsensus instantiates a new consensus object
 func NewConsensus(db db.DB, chain chain.Chain, testing bool) Consensus {
 	genesisTimestamp := chain.GetGenesisMomentum().Timestamp
 	epochTicker := common.NewTicker(*genesisTimestamp, EpochDuration)
-	cacheSize := 7 * 24 * 60 * 60 / (constants.ConsensusConfig.BlockTime * int64(constants.ConsensusConfig.NodeCount))
+	config := chain.GetChainParams().Consensus
+	cacheSize := 7 * 24 * 60 * 60 / (config.BlockTime * int64(config.NodeCount))
 
 	dbCache := storage.NewConsensusDB(db, int(cacheSize), int(cacheSize))
-	electionManager := newElectionManager(chain, dbCache)
+	electionManager := newElectionManager(chain, epochTicker, dbCache)
 
 	return &consensus{
 		log:             common.ConsensusLogger,
 		genesis:         *genesisTimestamp,
 		chain:           chain,
+		db:              db,
 		testing:         testing,
 		eventManager:    newEventManager(),
 		electionManager: electionManager,
 		points:          newPoints(electionManager, epochTicker, chain, dbCache),
+		liveness:        newLiveness(chain, electionManager),
 		closed:          make(chan struct{}),
 	}
 }
@@ -94,7 +116,7 @@ func (cs *consensus) VerifyMomentumProducer(momentum *nom.Momentum) (bool, error
 }
 
 func (cs *consensus) Init() error {
-	return nil
+	return cs.electionManager.loadParameters()
 }
 func (cs *consensus) Start() error {
 	cs.log.Info("starting ...")
@@ -112,6 +134,7 @@ func (cs *consensus) Start() error {
 
 	cs.chain.Register(cs.points)
 	cs.chain.Register(cs.electionManager)
+	cs.chain.Register(cs.liveness)
 	return nil
 }
 func (cs *consensus) Stop() error {
@@ -120,6 +143,7 @@ func (cs *consensus) Stop() error {
 
 	cs.chain.UnRegister(cs.points)
 	cs.chain.UnRegister(cs.electionManager)
+	cs.chain.UnRegister(cs.liveness)
 
 	close(cs.closed)
 	cs.wg.Wait()
@@ -13,8 +13,7 @@ type Context struct {
 	GenesisTime time.Time
 }
 
-func NewConsensusContext(genesisTime time.Time) *Context {
-	config := constants.ConsensusConfig
+func NewConsensusContext(genesisTime time.Time, config *constants.Consensus) *Context {
 	context := &Context{
 		Consensus:   *config,
 		GenesisTime: genesisTime,
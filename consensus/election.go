@@ -1,12 +1,14 @@
 package consensus
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus/storage"
@@ -28,12 +30,25 @@ func getMomentumBeforeTime(chain chain.Chain, t time.Time) (*nom.Momentum, error
 	return block, nil
 }
 
+// getMomentumStore fails for unknown identifiers too, since the consensus only asks for momentums of the chain
+func getMomentumStore(chain chain.Chain, identifier types.HashHeight) (store.Momentum, error) {
+	momentumStore, err := chain.GetMomentumStore(identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get momentum store for %v", identifier)
+	}
+	if momentumStore == nil {
+		return nil, errors.Errorf("can't find momentum store for %v", identifier)
+	}
+	return momentumStore, nil
+}
+
 type electionResult struct {
 	STime       time.Time
 	ETime       time.Time
 	Producers   []*ProducerEvent
 	Delegations []*types.PillarDelegation
 	Tick        uint64
+	Proof       types.HashHeight
 }
 
 func generateProducers(info *Context, tick uint64, producerAddresses []types.Address) []*ProducerEvent {
@@ -70,15 +85,17 @@ type electionManager struct {
 	log common.Logger
 	Context
 
-	chain chain.Chain
-	algo  ElectionAlgorithm
-	db    *storage.DB
+	chain    chain.Chain
+	schedule *parameterSchedule
+	db       *storage.DB
 }
 type ElectionReader interface {
 	common.Ticker
 	ElectionByTime(t time.Time) (*electionResult, error)
 	ElectionByTick(tick uint64) (*electionResult, error)
 	DelegationsByTick(tick uint64) ([]*types.PillarDelegationDetail, error)
+	// ContextByTick returns the consensus parameters used by the election tick
+	ContextByTick(tick uint64) *Context
 }
 
 func (em *electionManager) ElectionByTime(t time.Time) (*electionResult, error) {
@@ -101,13 +118,15 @@ func (em *electionManager) ElectionByTick(tick uint64) (*electionResult, error)
 
 	em.log.Debug("election", "tick", tick, "hash", proofBlock.Hash, "time", proofTime)
 
-	data, err := em.generateProducers(proofBlock)
+	segment := em.schedule.segmentByTick(tick)
+	data, err := em.generateProducers(segment, proofBlock)
 	if err != nil {
 		em.log.Error("generateProducers failed", "reason", err)
 		return nil, err
 	}
 
-	result := genElectionResult(&em.Context, tick, data)
+	result := genElectionResult(segment.context, tick, data)
+	result.Proof = proofBlock.Identifier()
 
 	// Set name to plan members
 	registerMap := make(map[types.Address]string)
@@ -126,6 +145,9 @@ func (em *electionManager) ElectionByTick(tick uint64) (*electionResult, error)
 
 	return result, nil
 }
+func (em *electionManager) ContextByTick(tick uint64) *Context {
+	return em.schedule.segmentByTick(tick).context
+}
 func (em *electionManager) DelegationsByTick(tick uint64) ([]*types.PillarDelegationDetail, error) {
 	proofTime := em.genProofTime(tick)
 	proofBlock, err := getMomentumBeforeTime(em.chain, proofTime)
@@ -133,9 +155,37 @@ func (em *electionManager) DelegationsByTick(tick uint64) ([]*types.PillarDelega
 		em.log.Error("GetMomentumBeforeTime failed", "reason", err)
 		return nil, err
 	}
-	store := em.chain.GetMomentumStore(proofBlock.Identifier())
+	momentumStore, err := getMomentumStore(em.chain, proofBlock.Identifier())
+	if err != nil {
+		return nil, err
+	}
+
+	return em.computeDelegations(momentumStore)
+}
+
+// computeDelegations returns the producer candidates of the consensus mode.
+// Authorities of POA chains have the same weight and no backers.
+func (em *electionManager) computeDelegations(momentumStore store.Momentum) ([]*types.PillarDelegationDetail, error) {
+	if em.Mode != constants.ProofOfAuthority {
+		return momentumStore.ComputePillarDelegations()
+	}
 
-	return store.ComputePillarDelegations()
+	authorities, err := momentumStore.GetAuthorities()
+	if err != nil {
+		return nil, err
+	}
+	delegations := make([]*types.PillarDelegationDetail, 0, len(authorities))
+	for _, authority := range authorities {
+		delegations = append(delegations, &types.PillarDelegationDetail{
+			PillarDelegation: types.PillarDelegation{
+				Name:      authority.Name,
+				Producing: authority.ProducerAddress,
+				Weight:    big.NewInt(1),
+			},
+			Backers: make(map[types.Address]*big.Int),
+		})
+	}
+	return delegations, nil
 }
 func (em *electionManager) genProofTime(tick uint64) time.Time {
 	if tick < 2 {
@@ -145,11 +195,34 @@ func (em *electionManager) genProofTime(tick uint64) time.Time {
 	return endTime
 }
 
-func (em *electionManager) generateProducers(proofBlock *nom.Momentum) (*storage.ElectionData, error) {
+// electionDataByTick returns the election data of tick together with its cache key
+func (em *electionManager) electionDataByTick(tick uint64) (types.Hash, *storage.ElectionData, error) {
+	proofBlock, err := getMomentumBeforeTime(em.chain, em.genProofTime(tick))
+	if err != nil {
+		return types.ZeroHash, nil, err
+	}
+	segment := em.schedule.segmentByTick(tick)
+	data, err := em.generateProducers(segment, proofBlock)
+	if err != nil {
+		return types.ZeroHash, nil, err
+	}
+	return em.electionKey(segment, proofBlock.Hash), data, nil
+}
+
+// electionKey returns the cache key of the election seeded by proofHash.
+// Elections of committees which differ from the genesis one also depend on the committee size.
+func (em *electionManager) electionKey(segment *consensusSegment, proofHash types.Hash) types.Hash {
+	if segment.context.NodeCount == em.NodeCount && segment.context.RandCount == em.RandCount {
+		return proofHash
+	}
+	return types.NewHash(common.JoinBytes(proofHash.Bytes(), []byte{segment.context.NodeCount, segment.context.RandCount}))
+}
+
+func (em *electionManager) generateProducers(segment *consensusSegment, proofBlock *nom.Momentum) (*storage.ElectionData, error) {
 	hashH := types.HashHeight{Hash: proofBlock.Hash, Height: proofBlock.Height}
-	store := em.chain.GetMomentumStore(proofBlock.Identifier())
+	key := em.electionKey(segment, hashH.Hash)
 	// load from cache
-	cached, err := em.db.GetElectionResultByHash(hashH.Hash)
+	cached, err := em.db.GetElectionResultByHash(key)
 	if err != nil {
 		return nil, err
 	}
@@ -159,14 +232,18 @@ func (em *electionManager) generateProducers(proofBlock *nom.Momentum) (*storage
 	}
 
 	// get delegations
-	delegationsDetailed, err := store.ComputePillarDelegations()
+	momentumStore, err := getMomentumStore(em.chain, proofBlock.Identifier())
+	if err != nil {
+		return nil, err
+	}
+	delegationsDetailed, err := em.computeDelegations(momentumStore)
 	if err != nil {
 		return nil, err
 	}
 	delegations := types.ToPillarDelegation(delegationsDetailed)
 
 	context := NewAlgorithmContext(delegations, &hashH)
-	finalProducers := em.algo.SelectProducers(context)
+	finalProducers := segment.algo.SelectProducers(context)
 	producers := make([]types.Address, 0, len(finalProducers))
 	for _, v := range finalProducers {
 		producers = append(producers, v.Producing)
@@ -176,7 +253,7 @@ func (em *electionManager) generateProducers(proofBlock *nom.Momentum) (*storage
 
 	// update cache
 	electionData := storage.GenElectionData(producers, delegations)
-	err = em.db.StoreElectionResultByHash(hashH.Hash, electionData)
+	err = em.db.StoreElectionResultByHash(key, electionData)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +264,12 @@ func (em *electionManager) generateProducers(proofBlock *nom.Momentum) (*storage
 func (em *electionManager) InsertMomentum(detailed *nom.DetailedMomentum) {
 	block := detailed.Momentum
 
+	if em.schedule.isPending(block.Height) || touchesSporkContract(block) {
+		if err := em.loadParametersAt(block.Identifier()); err != nil {
+			em.log.Error("failed to load consensus parameters", "reason", err)
+		}
+	}
+
 	tick := em.ToTick(*block.Timestamp)
 	if tick == 0 {
 		return
@@ -201,34 +284,57 @@ func (em *electionManager) InsertMomentum(detailed *nom.DetailedMomentum) {
 		return
 	}
 
-	_, err = em.generateProducers(header)
+	_, err = em.generateProducers(em.schedule.segmentByTick(tick+1), header)
 	if err != nil {
 		em.log.Error("failed to generateProducers", "reason", err)
 		return
 	}
 }
-func (em *electionManager) DeleteMomentum(*nom.DetailedMomentum) {
-	// No need to worry about deleted momentums since electionData uses the proofBlock hash as a key
-	return
+func (em *electionManager) DeleteMomentum(detailed *nom.DetailedMomentum) {
+	// No need to worry about deleted momentums since electionData uses the proofBlock hash as a key.
+	// The consensus sporks are reloaded since the deleted momentum could have enforced one.
+	block := detailed.Momentum
+	previous := types.HashHeight{Hash: block.PreviousHash, Height: block.Height - 1}
+	if err := em.loadParametersAt(previous); err != nil {
+		em.log.Error("failed to load consensus parameters", "reason", err)
+	}
 }
 
-func newElectionManager(chain chain.Chain, db *storage.DB) *electionManager {
-	context := NewConsensusContext(*chain.GetGenesisMomentum().Timestamp)
-	if constants.ConsensusConfig.Algorithm == constants.UNIFORM {
-		return &electionManager{
-			Context: *context,
-			chain:   chain,
-			algo:    NewUniformElectionAlgorithm(context),
-			db:      db,
-			log:     common.ConsensusLogger.New("submodule", "uniform-election-manager"),
-		}
+// loadParameters loads the changes of the consensus parameters made by sporks up to the frontier momentum
+func (em *electionManager) loadParameters() error {
+	return em.schedule.load(em.chain.GetFrontierMomentumStore())
+}
 
+func (em *electionManager) loadParametersAt(identifier types.HashHeight) error {
+	momentumStore, err := getMomentumStore(em.chain, identifier)
+	if err != nil {
+		return err
 	}
+	return em.schedule.load(momentumStore)
+}
+
+func touchesSporkContract(block *nom.Momentum) bool {
+	for _, header := range block.Content {
+		if header.Address == types.SporkContract {
+			return true
+		}
+	}
+	return false
+}
+
+func newElectionManager(chain chain.Chain, epochTicker common.Ticker, db *storage.DB) *electionManager {
+	config := chain.GetChainParams().Consensus
+	genesisTime := *chain.GetGenesisMomentum().Timestamp
+	schedule := newParameterSchedule(genesisTime, config, epochTicker)
 	return &electionManager{
-		Context: *context,
-		chain:   chain,
-		algo:    NewElectionAlgorithm(context),
-		db:      db,
-		log:     common.ConsensusLogger.New("submodule", "election-manager"),
+		Context: Context{
+			Ticker:      schedule,
+			Consensus:   *config,
+			GenesisTime: genesisTime,
+		},
+		chain:    chain,
+		schedule: schedule,
+		db:       db,
+		log:      common.ConsensusLogger.New("submodule", electionManagerName(config.Algorithm)),
 	}
 }
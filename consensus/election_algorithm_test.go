@@ -66,13 +66,13 @@ func checkUnequalOrder(t *testing.T, a, b []*types.PillarDelegation) {
 // 1. There are always Context.NodeCount nodes returned by the election algorithm even when there are less of them.
 // 2. The produced blocks are distributed uniformly among them.
 func TestAlgo_fillPillars(t *testing.T) {
-	constants.ConsensusConfig = &constants.Consensus{
+	config := &constants.Consensus{
 		BlockTime:   1,
 		NodeCount:   5,
 		RandCount:   2,
 		CountingZTS: types.ZnnTokenStandard,
 	}
-	smallCG := NewConsensusContext(time.Unix(2000000000, 0))
+	smallCG := NewConsensusContext(time.Unix(2000000000, 0), config)
 	for numPillars := 1; numPillars <= 5; numPillars++ {
 		delegations := generateDelegationInfo(numPillars)
 		numIterations := 12000
@@ -95,13 +95,13 @@ func TestAlgo_fillPillars(t *testing.T) {
 }
 
 func TestAlgo_seed(t *testing.T) {
-	constants.ConsensusConfig = &constants.Consensus{
+	config := &constants.Consensus{
 		BlockTime:   1,
 		NodeCount:   5,
 		RandCount:   2,
 		CountingZTS: types.ZnnTokenStandard,
 	}
-	smallCG := NewConsensusContext(time.Unix(2000000000, 0))
+	smallCG := NewConsensusContext(time.Unix(2000000000, 0), config)
 	ag := NewElectionAlgorithm(smallCG)
 
 	numPillars := 3
@@ -114,6 +114,54 @@ func TestAlgo_seed(t *testing.T) {
 	}
 }
 
+// Checks that UNIFORM_HASH
+// 1. Returns the same producers for the same proof momentum.
+// 2. Depends on the proof hash and not on the height.
+// 3. Distributes the produced blocks uniformly.
+func TestAlgo_uniformHashSeed(t *testing.T) {
+	config := &constants.Consensus{
+		BlockTime:   1,
+		NodeCount:   5,
+		RandCount:   2,
+		CountingZTS: types.ZnnTokenStandard,
+		Algorithm:   constants.UNIFORM_HASH,
+	}
+	smallCG := NewConsensusContext(time.Unix(2000000000, 0), config)
+	ag := NewUniformHashElectionAlgorithm(smallCG)
+
+	numPillars := 7
+	delegations := generateDelegationInfo(numPillars)
+	hash1 := types.NewHash([]byte("proof-1"))
+	hash2 := types.NewHash([]byte("proof-2"))
+	{
+		tmp_1 := ag.SelectProducers(NewAlgorithmContext(delegations, &types.HashHeight{Hash: hash1, Height: 1}))
+		tmp_2 := ag.SelectProducers(NewAlgorithmContext(delegations, &types.HashHeight{Hash: hash1, Height: 1}))
+		for i := range tmp_1 {
+			if tmp_1[i].Name != tmp_2[i].Name {
+				t.Fatalf("Expected the same order for the same proof momentum")
+			}
+		}
+	}
+	{
+		tmp_1 := ag.SelectProducers(NewAlgorithmContext(delegations, &types.HashHeight{Hash: hash1, Height: 1}))
+		tmp_2 := ag.SelectProducers(NewAlgorithmContext(delegations, &types.HashHeight{Hash: hash2, Height: 1}))
+		checkUnequalOrder(t, tmp_1, tmp_2)
+	}
+
+	numIterations := 12000
+	merged := make(map[string]int)
+	for j := 0; j < numIterations; j++ {
+		hash := types.NewHash([]byte(fmt.Sprintf("proof-%d", j)))
+		tmp := ag.SelectProducers(NewAlgorithmContext(delegations, &types.HashHeight{Hash: hash, Height: 1}))
+		mergeProducedNum(merged, tmp)
+	}
+	var expected = map[string]int{}
+	for j := 0; j < numPillars; j++ {
+		expected[pillarName(j)] = 5 * numIterations / numPillars
+	}
+	checkExpectedResults(t, expected, merged, 0.98)
+}
+
 func pillarBlockProductionChx(cg *Context, numPillars int) (topPillarChx, restChx float64) {
 	topPercentage := float64(cg.NodeCount-cg.RandCount) / float64(cg.NodeCount)
 	restChx = float64(cg.RandCount) / float64(numPillars-int(cg.NodeCount-cg.RandCount))
@@ -123,13 +171,13 @@ func pillarBlockProductionChx(cg *Context, numPillars int) (topPillarChx, restCh
 
 // Checks distribution of momentum production on multiple pillars
 func TestAlgo_bigDistribution(t *testing.T) {
-	constants.ConsensusConfig = &constants.Consensus{
+	config := &constants.Consensus{
 		BlockTime:   1,
 		NodeCount:   30,
 		RandCount:   15,
 		CountingZTS: types.ZnnTokenStandard,
 	}
-	cg := NewConsensusContext(time.Unix(2000000000, 0))
+	cg := NewConsensusContext(time.Unix(2000000000, 0), config)
 
 	for _, numPillars := range []int{31, 50, 75, 100, 150} {
 		delegations := generateDelegationInfo(numPillars)
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"encoding/binary"
 	"math/rand"
 	"sort"
 
@@ -8,7 +9,8 @@ import (
 )
 
 type uniformElectionAlgorithm struct {
-	group *Context
+	group    *Context
+	hashSeed bool
 }
 
 func NewUniformElectionAlgorithm(group *Context) *uniformElectionAlgorithm {
@@ -17,7 +19,19 @@ func NewUniformElectionAlgorithm(group *Context) *uniformElectionAlgorithm {
 	}
 }
 
+// NewUniformHashElectionAlgorithm returns the uniform algorithm seeded with the hash of the proof momentum.
+// The seed is still deterministic for a given proof momentum, which keeps the producers verifiable.
+func NewUniformHashElectionAlgorithm(group *Context) *uniformElectionAlgorithm {
+	return &uniformElectionAlgorithm{
+		group:    group,
+		hashSeed: true,
+	}
+}
+
 func (ea *uniformElectionAlgorithm) findSeed(context *AlgorithmConfig) int64 {
+	if ea.hashSeed {
+		return int64(binary.BigEndian.Uint64(context.hashH.Hash.Bytes()[:8]))
+	}
 	return int64(context.hashH.Height)
 }
 
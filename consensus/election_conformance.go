@@ -0,0 +1,102 @@
+package consensus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+const conformanceMaxPillars = 12
+const conformanceProofMomentums = 20
+
+// conformanceDelegations returns numPillars delegations, with equal weights if equalWeights is set.
+// The delegations are listed in reverse order if reversed is set.
+func conformanceDelegations(numPillars int, equalWeights, reversed bool) []*types.PillarDelegation {
+	delegations := make([]*types.PillarDelegation, numPillars)
+	for i := 0; i < numPillars; i++ {
+		weight := big.NewInt(100)
+		if !equalWeights {
+			weight = big.NewInt(int64(1000 - i))
+		}
+		producing := types.Address{}
+		producing[types.AddressSize-1] = byte(i)
+		index := i
+		if reversed {
+			index = numPillars - 1 - i
+		}
+		delegations[index] = &types.PillarDelegation{
+			Name:      fmt.Sprintf("pillar_%d", i),
+			Producing: producing,
+			Weight:    weight,
+		}
+	}
+	return delegations
+}
+
+func conformanceProof(height uint64) *types.HashHeight {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, height)
+	return &types.HashHeight{
+		Hash:   types.NewHash(data),
+		Height: height,
+	}
+}
+
+func producerNames(producers []*types.PillarDelegation) []string {
+	names := make([]string, len(producers))
+	for i, producer := range producers {
+		names[i] = producer.Name
+	}
+	return names
+}
+
+// CheckElectionAlgorithm runs the checks which every election algorithm must pass before being registered:
+//   - SelectProducers returns exactly NodeCount producers, all of them taken from the delegations
+//   - the producers depend only on the delegations and the proof momentum, so every node computes the same ones
+//   - the order in which the delegations are given doesn't matter, including for pillars with equal weights
+func CheckElectionAlgorithm(t common.T, factory ElectionAlgorithmFactory) {
+	group := NewConsensusContext(time.Unix(2000000000, 0), &constants.Consensus{
+		BlockTime:   1,
+		NodeCount:   5,
+		RandCount:   2,
+		CountingZTS: types.ZnnTokenStandard,
+	})
+
+	for numPillars := 1; numPillars <= conformanceMaxPillars; numPillars += 1 {
+		for _, equalWeights := range []bool{false, true} {
+			for height := uint64(1); height <= conformanceProofMomentums; height += 1 {
+				algorithm := factory(group)
+				producers := algorithm.SelectProducers(NewAlgorithmContext(conformanceDelegations(numPillars, equalWeights, false), conformanceProof(height)))
+
+				if len(producers) != int(group.NodeCount) {
+					t.Fatalf("expected %v producers for %v pillars but got %v", group.NodeCount, numPillars, len(producers))
+				}
+				for _, producer := range producers {
+					if producer == nil {
+						t.Fatalf("expected only delegations as producers but got nil for %v pillars", numPillars)
+						return
+					}
+					var index int
+					if _, err := fmt.Sscanf(producer.Name, "pillar_%d", &index); err != nil || index >= numPillars {
+						t.Fatalf("expected only delegations as producers but got %v for %v pillars", producer.Name, numPillars)
+					}
+				}
+
+				expected := fmt.Sprintf("%v", producerNames(producers))
+				again := factory(group).SelectProducers(NewAlgorithmContext(conformanceDelegations(numPillars, equalWeights, false), conformanceProof(height)))
+				if current := fmt.Sprintf("%v", producerNames(again)); current != expected {
+					t.Fatalf("expected deterministic producers for %v pillars at height %v. Expected %v but got %v", numPillars, height, expected, current)
+				}
+				reversed := algorithm.SelectProducers(NewAlgorithmContext(conformanceDelegations(numPillars, equalWeights, true), conformanceProof(height)))
+				if current := fmt.Sprintf("%v", producerNames(reversed)); current != expected {
+					t.Fatalf("expected producers independent of the delegations order for %v pillars at height %v, equal weights %v. Expected %v but got %v", numPillars, height, equalWeights, expected, current)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package consensus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+// ElectionAlgorithmFactory creates the election algorithm of a consensus group
+type ElectionAlgorithmFactory func(group *Context) ElectionAlgorithm
+
+var (
+	electionAlgorithmsLock sync.RWMutex
+	electionAlgorithms     = make(map[constants.ElectionAlgorithm]ElectionAlgorithmFactory)
+)
+
+func init() {
+	RegisterElectionAlgorithm(constants.MAINNET, func(group *Context) ElectionAlgorithm {
+		return NewElectionAlgorithm(group)
+	})
+	RegisterElectionAlgorithm(constants.UNIFORM, func(group *Context) ElectionAlgorithm {
+		return NewUniformElectionAlgorithm(group)
+	})
+	RegisterElectionAlgorithm(constants.UNIFORM_HASH, func(group *Context) ElectionAlgorithm {
+		return NewUniformHashElectionAlgorithm(group)
+	})
+}
+
+// RegisterElectionAlgorithm makes an election algorithm selectable by name from the HyperQube section of the genesis.
+// Should be called from an init function, every node of a chain must register the same algorithms.
+// Panics if the name is already registered.
+func RegisterElectionAlgorithm(name constants.ElectionAlgorithm, factory ElectionAlgorithmFactory) {
+	electionAlgorithmsLock.Lock()
+	defer electionAlgorithmsLock.Unlock()
+	if name == "" || factory == nil {
+		panic("invalid election algorithm registration")
+	}
+	if _, ok := electionAlgorithms[name]; ok {
+		panic(fmt.Sprintf("election algorithm %v is already registered", name))
+	}
+	electionAlgorithms[name] = factory
+}
+
+// ParseElectionAlgorithm returns the registered election algorithm with the given name, as used in genesis files
+func ParseElectionAlgorithm(name string) (constants.ElectionAlgorithm, error) {
+	electionAlgorithmsLock.RLock()
+	defer electionAlgorithmsLock.RUnlock()
+	if _, ok := electionAlgorithms[constants.ElectionAlgorithm(name)]; !ok {
+		return constants.MAINNET, errors.Errorf("unknown election algorithm %q", name)
+	}
+	return constants.ElectionAlgorithm(name), nil
+}
+
+// NewElectionAlgorithmByName creates the registered election algorithm with the given name
+func NewElectionAlgorithmByName(name constants.ElectionAlgorithm, group *Context) (ElectionAlgorithm, error) {
+	electionAlgorithmsLock.RLock()
+	factory, ok := electionAlgorithms[name]
+	electionAlgorithmsLock.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unknown election algorithm %q", name)
+	}
+	return factory(group), nil
+}
+
+// RegisteredElectionAlgorithms returns the names of all the registered election algorithms, sorted
+func RegisteredElectionAlgorithms() []constants.ElectionAlgorithm {
+	electionAlgorithmsLock.RLock()
+	defer electionAlgorithmsLock.RUnlock()
+	names := make([]constants.ElectionAlgorithm, 0, len(electionAlgorithms))
+	for name := range electionAlgorithms {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// electionManagerName returns the logger submodule of the election manager, e.g. uniform-hash-election-manager
+func electionManagerName(name constants.ElectionAlgorithm) string {
+	if name == constants.MAINNET {
+		return "election-manager"
+	}
+	return strings.ToLower(strings.ReplaceAll(string(name), "_", "-")) + "-election-manager"
+}
@@ -0,0 +1,77 @@
+package consensus
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+const testRoundRobin = constants.ElectionAlgorithm("TEST_ROUND_ROBIN")
+
+// roundRobinElectionAlgorithm selects the pillars by weight, starting with a different pillar at every proof momentum
+type roundRobinElectionAlgorithm struct {
+	group *Context
+}
+
+func (ea *roundRobinElectionAlgorithm) SelectProducers(context *AlgorithmConfig) []*types.PillarDelegation {
+	sort.Sort(types.SortPDByWeight(context.delegations))
+	result := make([]*types.PillarDelegation, 0, ea.group.NodeCount)
+	for i := 0; i < int(ea.group.NodeCount); i += 1 {
+		index := (int(context.hashH.Height) + i) % len(context.delegations)
+		result = append(result, context.delegations[index])
+	}
+	return result
+}
+
+func init() {
+	RegisterElectionAlgorithm(testRoundRobin, func(group *Context) ElectionAlgorithm {
+		return &roundRobinElectionAlgorithm{group: group}
+	})
+}
+
+func TestElectionRegistry_Conformance(t *testing.T) {
+	for _, name := range RegisteredElectionAlgorithms() {
+		name := name
+		t.Run(name.String(), func(t *testing.T) {
+			CheckElectionAlgorithm(t, func(group *Context) ElectionAlgorithm {
+				algorithm, err := NewElectionAlgorithmByName(name, group)
+				common.FailIfErr(t, err)
+				return algorithm
+			})
+		})
+	}
+}
+
+func TestElectionRegistry_Names(t *testing.T) {
+	common.ExpectJson(t, RegisteredElectionAlgorithms(), `
+[
+	"MAINNET",
+	"TEST_ROUND_ROBIN",
+	"UNIFORM",
+	"UNIFORM_HASH"
+]`)
+
+	// registered algorithms can be selected from genesis
+	algorithm, err := ParseElectionAlgorithm("TEST_ROUND_ROBIN")
+	common.FailIfErr(t, err)
+	common.ExpectString(t, algorithm.String(), "TEST_ROUND_ROBIN")
+	_, err = ParseElectionAlgorithm("ROUND_ROBIN")
+	common.ExpectString(t, err.Error(), `unknown election algorithm "ROUND_ROBIN"`)
+	_, err = NewElectionAlgorithmByName("ROUND_ROBIN", nil)
+	common.ExpectString(t, err.Error(), `unknown election algorithm "ROUND_ROBIN"`)
+
+	common.ExpectString(t, electionManagerName(constants.MAINNET), "election-manager")
+	common.ExpectString(t, electionManagerName(constants.UNIFORM_HASH), "uniform-hash-election-manager")
+}
+
+func TestElectionRegistry_Duplicate(t *testing.T) {
+	defer func() {
+		common.ExpectString(t, recover().(string), "election algorithm UNIFORM is already registered")
+	}()
+	RegisterElectionAlgorithm(constants.UNIFORM, func(group *Context) ElectionAlgorithm {
+		return NewUniformElectionAlgorithm(group)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus/api"
 )
@@ -29,10 +30,20 @@ type EventManager interface {
 	UnRegister(callback EventListener)
 }
 
+type MissedSlotListener interface {
+	NewMissedSlot(api.MissedSlot)
+}
+
+type LivenessEventManager interface {
+	RegisterMissedSlotListener(listener MissedSlotListener)
+	UnRegisterMissedSlotListener(listener MissedSlotListener)
+}
+
 // Consensus include all interface for consensus
 type Consensus interface {
 	Verifier
 	EventManager
+	LivenessEventManager
 
 	Init() error
 	Start() error
@@ -42,4 +53,13 @@ type Consensus interface {
 
 	FrontierPillarReader() api.PillarReader
 	FixedPillarReader(types.HashHeight) api.PillarReader
+	ElectionScheduleReader() api.ElectionScheduleReader
+	LivenessReader() api.LivenessReader
+
+	// GetSnapshotEntries returns the entries of the consensus DB which are part of a snapshot of the state of momentum,
+	// in key order starting at from, see db.DumpDBFrom. All the nodes return the same entries for the same momentum.
+	GetSnapshotEntries(momentum *nom.Momentum, from []byte, limit int) (db.Patch, []byte, error)
+	// InsertSnapshotEntries stores the entries returned by GetSnapshotEntries for momentum,
+	// before the chain installs the state of momentum, since its elections and points can't be recomputed without the previous states
+	InsertSnapshotEntries(momentum *nom.Momentum, entries db.DB) error
 }
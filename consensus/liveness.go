@@ -0,0 +1,255 @@
+package consensus
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/consensus/api"
+)
+
+var (
+	// LivenessWindowTicks is the number of election ticks covered by the liveness window
+	LivenessWindowTicks uint64 = 12
+)
+
+// liveness records which scheduled slots got a momentum.
+// Slot records are computed from the chain so they follow rollbacks,
+// the consecutive missed slots of each pillar are tracked as momentums come to broadcast MissedSlot events.
+type liveness struct {
+	log      common.Logger
+	chain    chain.Chain
+	election *electionManager
+	ticker   ChainTicker
+
+	listeners     []MissedSlotListener
+	listenersLock sync.Mutex
+
+	changes sync.Mutex
+	// lastSlot is the start time of the last slot decided by an inserted momentum, zero if not known yet
+	lastSlot    time.Time
+	consecutive map[string]uint64
+	// election of the tick of lastSlot, saves a chain lookup for each momentum
+	lastElection *electionResult
+}
+
+func newLiveness(chain chain.Chain, election *electionManager) *liveness {
+	return &liveness{
+		log:         common.ConsensusLogger.New("submodule", "liveness"),
+		chain:       chain,
+		election:    election,
+		ticker:      newChainTicker(chain, election),
+		listeners:   make([]MissedSlotListener, 0),
+		consecutive: make(map[string]uint64),
+	}
+}
+
+func newSlotRecord(tick uint64, slot *ProducerEvent, momentum *nom.Momentum) *api.SlotRecord {
+	record := &api.SlotRecord{
+		Tick:      tick,
+		Name:      slot.Name,
+		Producer:  slot.Producer,
+		StartTime: slot.StartTime.Unix(),
+		EndTime:   slot.EndTime.Unix(),
+	}
+	if momentum != nil && momentum.Producer() == slot.Producer {
+		identifier := momentum.Identifier()
+		record.Produced = true
+		record.Momentum = &identifier
+	}
+	return record
+}
+
+// slotsByTick returns the slots of tick which start after the genesis and not later than decided
+func (l *liveness) slotsByTick(tick uint64, decided time.Time) ([]*api.SlotRecord, error) {
+	records := make([]*api.SlotRecord, 0)
+	if !l.ticker.HasStarted(tick) {
+		return records, nil
+	}
+
+	election, err := l.election.ElectionByTick(tick)
+	if err != nil {
+		return nil, err
+	}
+	momentums, err := l.ticker.GetContent(tick)
+	if err != nil {
+		return nil, err
+	}
+	byTime := make(map[int64]*nom.Momentum, len(momentums))
+	for _, momentum := range momentums {
+		byTime[momentum.Timestamp.Unix()] = momentum
+	}
+
+	for _, slot := range election.Producers {
+		// the genesis momentum takes the first slot of the chain
+		if !slot.StartTime.After(l.election.GenesisTime) {
+			continue
+		}
+		if slot.StartTime.After(decided) {
+			break
+		}
+		records = append(records, newSlotRecord(tick, slot, byTime[slot.StartTime.Unix()]))
+	}
+	return records, nil
+}
+
+func (l *liveness) SlotsByTick(tick uint64) ([]*api.SlotRecord, error) {
+	frontier, err := l.chain.GetFrontierMomentumStore().GetFrontierMomentum()
+	if err != nil {
+		return nil, err
+	}
+	return l.slotsByTick(tick, *frontier.Timestamp)
+}
+func (l *liveness) LivenessWindow() (*api.LivenessWindow, error) {
+	frontier, err := l.chain.GetFrontierMomentumStore().GetFrontierMomentum()
+	if err != nil {
+		return nil, err
+	}
+	return l.livenessWindow(frontier)
+}
+func (l *liveness) livenessWindow(frontier *nom.Momentum) (*api.LivenessWindow, error) {
+	window := &api.LivenessWindow{
+		ToTick:  l.election.ToTick(*frontier.Timestamp),
+		Pillars: make([]*api.PillarLiveness, 0),
+	}
+	if window.ToTick+1 > LivenessWindowTicks {
+		window.FromTick = window.ToTick + 1 - LivenessWindowTicks
+	}
+
+	pillars := make(map[string]*api.PillarLiveness)
+	for tick := window.FromTick; tick <= window.ToTick; tick += 1 {
+		records, err := l.slotsByTick(tick, *frontier.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			pillar, ok := pillars[record.Name]
+			if !ok {
+				pillar = &api.PillarLiveness{Name: record.Name}
+				pillars[record.Name] = pillar
+				window.Pillars = append(window.Pillars, pillar)
+			}
+			pillar.ScheduledNum += 1
+			if record.Produced {
+				pillar.ProducedNum += 1
+				pillar.ConsecutiveMissed = 0
+				pillar.LastProducedTime = record.StartTime
+			} else {
+				pillar.MissedNum += 1
+				pillar.ConsecutiveMissed += 1
+			}
+		}
+	}
+
+	sort.Slice(window.Pillars, func(i, j int) bool {
+		return window.Pillars[i].Name < window.Pillars[j].Name
+	})
+	return window, nil
+}
+
+func (l *liveness) broadcastMissedSlot(event api.MissedSlot) {
+	l.listenersLock.Lock()
+	defer l.listenersLock.Unlock()
+
+	for _, listener := range l.listeners {
+		listener.NewMissedSlot(event)
+	}
+}
+func (l *liveness) RegisterMissedSlotListener(listener MissedSlotListener) {
+	l.listenersLock.Lock()
+	defer l.listenersLock.Unlock()
+
+	l.listeners = append(l.listeners, listener)
+}
+func (l *liveness) UnRegisterMissedSlotListener(listener MissedSlotListener) {
+	l.listenersLock.Lock()
+	defer l.listenersLock.Unlock()
+
+	for index, current := range l.listeners {
+		if current == listener {
+			l.listeners = append(l.listeners[:index], l.listeners[index+1:]...)
+			break
+		}
+	}
+}
+
+func (l *liveness) InsertMomentum(detailed *nom.DetailedMomentum) {
+	l.changes.Lock()
+	defer l.changes.Unlock()
+
+	momentum := detailed.Momentum
+	timestamp := *momentum.Timestamp
+
+	// start tracking from the liveness window of the chain
+	if l.lastSlot.IsZero() {
+		window, err := l.livenessWindow(momentum)
+		if err != nil {
+			l.log.Error("failed to get liveness window", "reason", err, "momentum-identifier", momentum.Identifier())
+			return
+		}
+		l.consecutive = make(map[string]uint64, len(window.Pillars))
+		for _, pillar := range window.Pillars {
+			l.consecutive[pillar.Name] = pillar.ConsecutiveMissed
+		}
+		l.lastSlot = timestamp
+		return
+	}
+
+	// the momentums of the ticks before the previous one are inserted by the sync, their missed slots are not reported
+	synced := l.election.ToTick(timestamp)+1 >= l.election.ToTick(common.Clock.Now())
+
+	// the slots between the previous momentum and this one were missed
+	for tick := l.election.ToTick(l.lastSlot); tick <= l.election.ToTick(timestamp); tick += 1 {
+		election := l.lastElection
+		if election == nil || election.Tick != tick {
+			var err error
+			election, err = l.election.ElectionByTick(tick)
+			if err != nil {
+				l.log.Error("failed to get election", "reason", err, "tick", tick)
+				l.lastSlot = time.Time{}
+				return
+			}
+			l.lastElection = election
+		}
+		for _, slot := range election.Producers {
+			if !slot.StartTime.After(l.lastSlot) || !slot.StartTime.After(l.election.GenesisTime) {
+				continue
+			}
+			if slot.StartTime.After(timestamp) {
+				break
+			}
+
+			var produced *nom.Momentum
+			if slot.StartTime.Equal(timestamp) {
+				produced = momentum
+			}
+			record := newSlotRecord(tick, slot, produced)
+			if record.Produced {
+				l.consecutive[record.Name] = 0
+				continue
+			}
+
+			l.consecutive[record.Name] += 1
+			if !synced {
+				continue
+			}
+			l.log.Warn("missed slot", "name", record.Name, "producer", record.Producer, "start-time", record.StartTime, "consecutive", l.consecutive[record.Name])
+			l.broadcastMissedSlot(api.MissedSlot{
+				Slot:              record,
+				ConsecutiveMissed: l.consecutive[record.Name],
+			})
+		}
+	}
+	l.lastSlot = timestamp
+}
+func (l *liveness) DeleteMomentum(*nom.DetailedMomentum) {
+	l.changes.Lock()
+	defer l.changes.Unlock()
+
+	// recompute the consecutive missed slots from the chain on the next momentum
+	l.lastSlot = time.Time{}
+	l.lastElection = nil
+}
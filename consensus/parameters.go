@@ -0,0 +1,230 @@
+package consensus
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus/api"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+// consensusSegment holds the consensus parameters used by the election ticks since startTick.
+// The first segment starts at the genesis and uses the parameters of the chain params.
+type consensusSegment struct {
+	context   *Context
+	algo      ElectionAlgorithm
+	startTick uint64
+	startTime time.Time
+	// ticker counts the election ticks since startTime
+	ticker common.Ticker
+	// sporks which changed the parameters at the start of the segment
+	sporks []types.Hash
+}
+
+func (s *consensusSegment) toTick(t time.Time) uint64 {
+	return s.startTick + s.ticker.ToTick(t)
+}
+
+// parameterSchedule is the ticker of the election ticks.
+// Consensus sporks change the interval of the ticks at the start of an epoch, so the ticks are split into segments.
+type parameterSchedule struct {
+	log         common.Logger
+	lock        sync.RWMutex
+	segments    []*consensusSegment
+	epochTicker common.Ticker
+	genesisTime time.Time
+
+	// enforcement heights of the activated consensus sporks which are after the frontier momentum
+	pending map[uint64]bool
+}
+
+func newParameterSchedule(genesisTime time.Time, config *constants.Consensus, epochTicker common.Ticker) *parameterSchedule {
+	schedule := &parameterSchedule{
+		log:         common.ConsensusLogger.New("submodule", "parameter-schedule"),
+		epochTicker: epochTicker,
+		genesisTime: genesisTime,
+		pending:     make(map[uint64]bool),
+	}
+	schedule.segments = []*consensusSegment{schedule.newSegment(*config, 0, genesisTime, nil)}
+	return schedule
+}
+
+func (ps *parameterSchedule) newSegment(config constants.Consensus, startTick uint64, startTime time.Time, sporks []types.Hash) *consensusSegment {
+	context := &Context{
+		Ticker:      ps,
+		Consensus:   config,
+		GenesisTime: ps.genesisTime,
+	}
+	algo, err := NewElectionAlgorithmByName(config.Algorithm, context)
+	common.DealWithErr(err)
+	return &consensusSegment{
+		context:   context,
+		algo:      algo,
+		startTick: startTick,
+		startTime: startTime,
+		ticker:    common.NewTicker(startTime, periodDuration(&config)),
+		sporks:    sporks,
+	}
+}
+
+func periodDuration(config *constants.Consensus) time.Duration {
+	return time.Second * time.Duration(uint64(config.BlockTime)*uint64(config.NodeCount))
+}
+
+func (ps *parameterSchedule) segmentByTick(tick uint64) *consensusSegment {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	for i := len(ps.segments) - 1; i > 0; i -= 1 {
+		if ps.segments[i].startTick <= tick {
+			return ps.segments[i]
+		}
+	}
+	return ps.segments[0]
+}
+func (ps *parameterSchedule) segmentByTime(t time.Time) *consensusSegment {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	for i := len(ps.segments) - 1; i > 0; i -= 1 {
+		if !ps.segments[i].startTime.After(t) {
+			return ps.segments[i]
+		}
+	}
+	return ps.segments[0]
+}
+
+func (ps *parameterSchedule) ToTime(tick uint64) (time.Time, time.Time) {
+	segment := ps.segmentByTick(tick)
+	return segment.ticker.ToTime(tick - segment.startTick)
+}
+func (ps *parameterSchedule) ToTick(t time.Time) uint64 {
+	return ps.segmentByTime(t).toTick(t)
+}
+
+// TickMultiplier works only as long as the parameters didn't change, since the number of ticks in an epoch is not fixed afterwards.
+// Use time ranges to convert ticks instead.
+func (ps *parameterSchedule) TickMultiplier(bigger common.Ticker) (uint64, error) {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	if len(ps.segments) != 1 {
+		return 0, errors.Errorf("ticker error - consensus parameters changed - can't convert ticks")
+	}
+	return ps.segments[0].ticker.TickMultiplier(bigger)
+}
+
+// isPending returns true if a consensus spork is enforced at height
+func (ps *parameterSchedule) isPending(height uint64) bool {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	return ps.pending[height]
+}
+
+type parameterChange struct {
+	spork      *definition.Spork
+	parameters *definition.SporkConsensusParameters
+}
+
+// load recomputes the segments from the consensus sporks of momentumStore.
+// The parameters change at the start of the first epoch after the momentum at the enforcement height of the spork,
+// sporks enforced in the same epoch are applied in order of their enforcement height.
+func (ps *parameterSchedule) load(momentumStore store.Momentum) error {
+	frontier, err := momentumStore.GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+	sporks, err := momentumStore.GetAllDefinedSporks()
+	if err != nil {
+		return err
+	}
+	list, err := momentumStore.GetAllSporkConsensusParameters()
+	if err != nil {
+		return err
+	}
+	parameters := make(map[types.Hash]*definition.SporkConsensusParameters, len(list))
+	for _, p := range list {
+		parameters[p.Id] = p
+	}
+
+	changes := make([]*parameterChange, 0)
+	for _, spork := range sporks {
+		if p, ok := parameters[spork.Id]; ok && spork.Activated {
+			changes = append(changes, &parameterChange{spork: spork, parameters: p})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].spork.EnforcementHeight != changes[j].spork.EnforcementHeight {
+			return changes[i].spork.EnforcementHeight < changes[j].spork.EnforcementHeight
+		}
+		return bytes.Compare(changes[i].spork.Id.Bytes(), changes[j].spork.Id.Bytes()) < 0
+	})
+
+	ps.lock.RLock()
+	segments := []*consensusSegment{ps.segments[0]}
+	ps.lock.RUnlock()
+	pending := make(map[uint64]bool)
+
+	for _, change := range changes {
+		if change.spork.EnforcementHeight > frontier.Height {
+			pending[change.spork.EnforcementHeight] = true
+			continue
+		}
+		momentum, err := momentumStore.GetMomentumByHeight(change.spork.EnforcementHeight)
+		if err != nil {
+			return err
+		}
+		if momentum == nil {
+			return errors.Errorf("can't find enforcement momentum of consensus spork %v at height %v", change.spork.Id, change.spork.EnforcementHeight)
+		}
+		startTime, _ := ps.epochTicker.ToTime(ps.epochTicker.ToTick(*momentum.Timestamp) + 1)
+
+		last := segments[len(segments)-1]
+		config := last.context.Consensus
+		config.NodeCount = change.parameters.NodeCount
+		config.RandCount = change.parameters.RandCount
+		if EpochDuration%periodDuration(&config) != 0 {
+			ps.log.Error("ignored consensus spork", "reason", "the election tick doesn't divide the epoch", "id", change.spork.Id, "node-count", config.NodeCount)
+			continue
+		}
+
+		if last.startTime.Equal(startTime) && len(segments) > 1 {
+			merged := append(append([]types.Hash{}, last.sporks...), change.spork.Id)
+			segments[len(segments)-1] = ps.newSegment(config, last.startTick, startTime, merged)
+		} else {
+			segments = append(segments, ps.newSegment(config, last.toTick(startTime), startTime, []types.Hash{change.spork.Id}))
+		}
+	}
+
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	if len(segments) != len(ps.segments) {
+		ps.log.Info("loaded consensus parameters", "segments", len(segments), "pending", len(pending))
+	}
+	ps.segments = segments
+	ps.pending = pending
+	return nil
+}
+
+// parameters returns the consensus parameters of every segment, in order
+func (ps *parameterSchedule) parameters() []*api.ConsensusParameters {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	list := make([]*api.ConsensusParameters, 0, len(ps.segments))
+	for _, segment := range ps.segments {
+		list = append(list, &api.ConsensusParameters{
+			StartTick: segment.startTick,
+			StartTime: segment.startTime.Unix(),
+			BlockTime: segment.context.BlockTime,
+			NodeCount: segment.context.NodeCount,
+			RandCount: segment.context.RandCount,
+			Sporks:    append([]types.Hash{}, segment.sporks...),
+		})
+	}
+	return list
+}
@@ -0,0 +1,39 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+func TestParameterSchedule_Segments(t *testing.T) {
+	genesis := time.Unix(2000000000, 0)
+	config := *constants.ConsensusConfig
+	schedule := newParameterSchedule(genesis, &config, common.NewTicker(genesis, time.Hour))
+	multiplier, err := schedule.TickMultiplier(schedule.epochTicker)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, multiplier, 12)
+
+	// block time 5 and 20 nodes since the second epoch
+	changed := config
+	changed.BlockTime = 5
+	changed.NodeCount = 20
+	start := genesis.Add(time.Hour)
+	schedule.segments = append(schedule.segments, schedule.newSegment(changed, schedule.segments[0].toTick(start), start, nil))
+
+	common.ExpectUint64(t, schedule.ToTick(start.Add(-time.Second)), 11)
+	common.ExpectUint64(t, schedule.ToTick(start), 12)
+	common.ExpectUint64(t, schedule.ToTick(start.Add(time.Hour)), 48)
+	sTime, eTime := schedule.ToTime(11)
+	common.ExpectTrue(t, sTime.Equal(start.Add(-300*time.Second)) && eTime.Equal(start))
+	sTime, eTime = schedule.ToTime(12)
+	common.ExpectTrue(t, sTime.Equal(start) && eTime.Equal(start.Add(100*time.Second)))
+	common.ExpectUint64(t, uint64(schedule.segmentByTick(12).context.NodeCount), 20)
+	common.ExpectUint64(t, uint64(schedule.segmentByTick(11).context.NodeCount), 30)
+
+	if _, err := schedule.TickMultiplier(schedule.epochTicker); err == nil {
+		t.Fatalf("expected the tick multiplier to fail after a change of the parameters")
+	}
+}
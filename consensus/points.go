@@ -24,7 +24,6 @@ type points struct {
 
 	lastCompletedPeriod int64
 	lastCompletedEpoch  int64
-	epochTickMultiplier int64
 }
 
 func newPoints(electionReader ElectionReader, epochTicker common.Ticker, ch chain.Chain, db *storage.DB) Points {
@@ -44,20 +43,19 @@ func newPoints(electionReader ElectionReader, epochTicker common.Ticker, ch chai
 		}
 	}
 
-	epochTickMultiplier, err := periodPoints.TickMultiplier(epochPoints)
-	if err != nil {
-		panic(err)
+	// the epoch of the last completed period may not be completed
+	var lastCompletedEpoch int64 = -1
+	if lastCompletedPeriod >= 0 {
+		sTime, _ := periodPoints.ToTime(uint64(lastCompletedPeriod))
+		lastCompletedEpoch = int64(epochPoints.ToTick(sTime)) - 1
 	}
 
-	lastCompletedEpoch := (lastCompletedPeriod / int64(epochTickMultiplier)) - 1
-
 	return &points{
 		log:                 common.ConsensusLogger.New("submodule", "points"),
 		periodPoints:        periodPoints,
 		epochPoints:         epochPoints,
 		lastCompletedPeriod: lastCompletedPeriod,
 		lastCompletedEpoch:  lastCompletedEpoch,
-		epochTickMultiplier: int64(epochTickMultiplier),
 	}
 }
 
@@ -72,7 +70,7 @@ func (p *points) InsertMomentum(detailed *nom.DetailedMomentum) {
 	block := detailed.Momentum
 
 	tick := int64(p.periodPoints.ToTick(*block.Timestamp))
-	epochTick := tick / p.epochTickMultiplier
+	epochTick := int64(p.epochPoints.ToTick(*block.Timestamp))
 
 	// update period ticks
 	for i := p.lastCompletedPeriod + 1; i < tick; i += 1 {
@@ -111,18 +109,12 @@ type PointsReader interface {
 }
 
 func newCompoundPoints(lower PointsReader, chainTicker ChainTicker, db *storage.DB, prefix byte) PointsReader {
-	lowerMultiplier, err := lower.TickMultiplier(chainTicker)
-	if err != nil {
-		panic(err)
-	}
-
 	return &compoundPoints{
-		ChainTicker:     chainTicker,
-		db:              db,
-		log:             common.ConsensusLogger.New("submodule", "compound-points", "compound-prefix", prefix),
-		prefix:          prefix,
-		lowerMultiplier: lowerMultiplier,
-		lower:           lower,
+		ChainTicker: chainTicker,
+		db:          db,
+		log:         common.ConsensusLogger.New("submodule", "compound-points", "compound-prefix", prefix),
+		prefix:      prefix,
+		lower:       lower,
 	}
 }
 
@@ -132,9 +124,9 @@ type compoundPoints struct {
 	log    common.Logger
 	prefix byte
 
-	// number of lower ticks that make a current tick
-	lowerMultiplier uint64
-	lower           PointsReader
+	// the lower ticks that make a current tick are found by time,
+	// since the number of lower ticks changes together with the consensus parameters
+	lower PointsReader
 }
 
 func (compound *compoundPoints) GetPoint(tick uint64) (*storage.Point, error) {
@@ -180,8 +172,9 @@ func (compound *compoundPoints) GetPoint(tick uint64) (*storage.Point, error) {
 }
 func (compound *compoundPoints) generatePointFromLower(tick uint64, endBlock *nom.Momentum) (*storage.Point, error) {
 	result := storage.NewEmptyPoint(endBlock.Hash)
-	start := tick * compound.lowerMultiplier
-	end := start + compound.lowerMultiplier
+	sTime, eTime := compound.ToTime(tick)
+	start := compound.lower.ToTick(sTime)
+	end := compound.lower.ToTick(eTime)
 	var numPresent int64 = 0
 
 	for i := end - 1; ; i-- {
@@ -203,7 +196,7 @@ func (compound *compoundPoints) generatePointFromLower(tick uint64, endBlock *no
 		}
 	}
 
-	// Divide weight by lowerMultiplier
+	// Divide weight by the number of lower points
 	result.TotalWeight.Set(big.NewInt(0))
 	bigRate := big.NewInt(numPresent)
 	for _, p := range result.Pillars {
@@ -0,0 +1,218 @@
+package consensus
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus/storage"
+)
+
+const (
+	DivergenceElection    = "election"
+	DivergencePeriodPoint = "period-point"
+	DivergenceEpochPoint  = "epoch-point"
+)
+
+// RebuildResult counts the entries written by RebuildDB
+type RebuildResult struct {
+	Elections    uint64 `json:"elections"`
+	PeriodPoints uint64 `json:"periodPoints"`
+	EpochPoints  uint64 `json:"epochPoints"`
+}
+
+// Divergence is an entry of the consensus DB which differs from the one recomputed from the chain.
+// Stored is empty if the entry is missing from the consensus DB.
+type Divergence struct {
+	Kind     string `json:"kind"`
+	Tick     uint64 `json:"tick"`
+	Stored   string `json:"stored"`
+	Computed string `json:"computed"`
+}
+
+// newOfflineConsensus returns a consensus which isn't registered to the chain, used to recompute the consensus DB
+func newOfflineConsensus(consensusDB db.DB, chain chain.Chain) (*consensus, error) {
+	cs := NewConsensus(consensusDB, chain, true).(*consensus)
+	if err := cs.Init(); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// completedTicks returns the number of period and epoch ticks completed by the frontier momentum
+func (cs *consensus) completedTicks() (uint64, uint64, error) {
+	frontier, err := cs.chain.GetFrontierMomentumStore().GetFrontierMomentum()
+	if err != nil {
+		return 0, 0, err
+	}
+	return cs.points.GetPeriodPoints().ToTick(*frontier.Timestamp), cs.points.GetEpochPoints().ToTick(*frontier.Timestamp), nil
+}
+
+// RebuildDB deletes the election results and points of consensusDB and recomputes them from the momentums of chain.
+// The consensus DB must not be used by a running node.
+func RebuildDB(consensusDB db.DB, chain chain.Chain) (*RebuildResult, error) {
+	log := common.ConsensusLogger.New("submodule", "rebuild")
+	if err := storage.NewConsensusDB(consensusDB, 1, 1).Clear(); err != nil {
+		return nil, err
+	}
+	cs, err := newOfflineConsensus(consensusDB, chain)
+	if err != nil {
+		return nil, err
+	}
+	periodTicks, epochTicks, err := cs.completedTicks()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RebuildResult{}
+	lastLog := time.Now()
+	for tick := uint64(0); tick < periodTicks; tick += 1 {
+		if _, _, err := cs.electionManager.electionDataByTick(tick); err != nil {
+			return nil, err
+		}
+		result.Elections += 1
+		if _, err := cs.points.GetPeriodPoints().GetPoint(tick); err != nil {
+			return nil, err
+		}
+		result.PeriodPoints += 1
+		if time.Since(lastLog) > 10*time.Second {
+			log.Info("rebuilding consensus DB", "tick", tick, "total", periodTicks)
+			lastLog = time.Now()
+		}
+	}
+	for tick := uint64(0); tick < epochTicks; tick += 1 {
+		if _, err := cs.points.GetEpochPoints().GetPoint(tick); err != nil {
+			return nil, err
+		}
+		result.EpochPoints += 1
+	}
+
+	log.Info("rebuilt consensus DB", "elections", result.Elections, "period-points", result.PeriodPoints, "epoch-points", result.EpochPoints)
+	return result, nil
+}
+
+// VerifyDB recomputes the election results and points of the completed ticks in scratchDB
+// and compares them, in chronological order, with the ones stored in consensusDB.
+// Returns the first divergence or nil if the stored entries match the chain.
+func VerifyDB(consensusDB db.DB, scratchDB db.DB, chain chain.Chain) (*Divergence, error) {
+	log := common.ConsensusLogger.New("submodule", "verify")
+	stored := storage.NewConsensusDB(consensusDB, 1, 1)
+	cs, err := newOfflineConsensus(scratchDB, chain)
+	if err != nil {
+		return nil, err
+	}
+	periodTicks, epochTicks, err := cs.completedTicks()
+	if err != nil {
+		return nil, err
+	}
+	periodPoints := cs.points.GetPeriodPoints()
+	epochPoints := cs.points.GetEpochPoints()
+
+	nextEpoch := uint64(0)
+	verifyEpochsUntil := func(t time.Time) (*Divergence, error) {
+		for ; nextEpoch < epochTicks; nextEpoch += 1 {
+			if _, eTime := epochPoints.ToTime(nextEpoch); eTime.After(t) {
+				break
+			}
+			if divergence, err := verifyPoint(stored, epochPoints, storage.PrefixEpochPoint, DivergenceEpochPoint, nextEpoch); divergence != nil || err != nil {
+				return divergence, err
+			}
+		}
+		return nil, nil
+	}
+
+	lastLog := time.Now()
+	for tick := uint64(0); tick < periodTicks; tick += 1 {
+		key, computed, err := cs.electionManager.electionDataByTick(tick)
+		if err != nil {
+			return nil, err
+		}
+		storedElection, err := stored.GetElectionResultByHash(key)
+		if err != nil {
+			return nil, err
+		}
+		if divergence, err := compareElections(tick, storedElection, computed); divergence != nil || err != nil {
+			return divergence, err
+		}
+
+		if divergence, err := verifyPoint(stored, periodPoints, storage.PrefixPeriodPoint, DivergencePeriodPoint, tick); divergence != nil || err != nil {
+			return divergence, err
+		}
+
+		_, eTime := periodPoints.ToTime(tick)
+		if divergence, err := verifyEpochsUntil(eTime); divergence != nil || err != nil {
+			return divergence, err
+		}
+		if time.Since(lastLog) > 10*time.Second {
+			log.Info("verifying consensus DB", "tick", tick, "total", periodTicks)
+			lastLog = time.Now()
+		}
+	}
+	if nextEpoch < epochTicks {
+		_, eTime := epochPoints.ToTime(epochTicks - 1)
+		if divergence, err := verifyEpochsUntil(eTime); divergence != nil || err != nil {
+			return divergence, err
+		}
+	}
+
+	log.Info("verified consensus DB", "period-ticks", periodTicks, "epoch-ticks", epochTicks)
+	return nil, nil
+}
+
+func compareElections(tick uint64, stored, computed *storage.ElectionData) (*Divergence, error) {
+	computedBytes, err := computed.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if stored != nil {
+		storedBytes, err := stored.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(storedBytes, computedBytes) {
+			return nil, nil
+		}
+	}
+	return &Divergence{
+		Kind:     DivergenceElection,
+		Tick:     tick,
+		Stored:   electionJson(stored),
+		Computed: electionJson(computed),
+	}, nil
+}
+
+func verifyPoint(stored *storage.DB, reader PointsReader, prefix byte, kind string, tick uint64) (*Divergence, error) {
+	computed, err := reader.GetPoint(tick)
+	if err != nil {
+		return nil, err
+	}
+	storedPoint, err := stored.GetPointByHeight(prefix, tick)
+	if err != nil {
+		return nil, err
+	}
+	if storedPoint != nil && computed != nil && storedPoint.Equal(computed) {
+		return nil, nil
+	}
+	divergence := &Divergence{
+		Kind: kind,
+		Tick: tick,
+	}
+	if storedPoint != nil {
+		divergence.Stored = storedPoint.Json()
+	}
+	if computed != nil {
+		divergence.Computed = computed.Json()
+	}
+	return divergence, nil
+}
+
+func electionJson(data *storage.ElectionData) string {
+	if data == nil {
+		return ""
+	}
+	bytes, _ := json.Marshal(data)
+	return string(bytes)
+}
@@ -0,0 +1,67 @@
+package consensus
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/consensus/api"
+)
+
+var (
+	ErrElectionNotComputed = errors.New("election tick after the next one, its proof momentum doesn't exist yet")
+)
+
+type scheduleReader struct {
+	em *electionManager
+}
+
+func (r *scheduleReader) CurrentTick() (uint64, error) {
+	now := common.Clock.Now()
+	if now.Before(r.em.GenesisTime) {
+		return 0, ErrElectionBeforeGenesis
+	}
+	return r.em.ToTick(now), nil
+}
+func (r *scheduleReader) ElectionByTick(tick uint64) (*api.ElectionSchedule, error) {
+	current, err := r.CurrentTick()
+	if err != nil {
+		return nil, err
+	}
+	if tick > current+1 {
+		return nil, ErrElectionNotComputed
+	}
+
+	election, err := r.em.ElectionByTick(tick)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &api.ElectionSchedule{
+		Tick:          election.Tick,
+		StartTime:     election.STime.Unix(),
+		EndTime:       election.ETime.Unix(),
+		Algorithm:     r.em.Algorithm.String(),
+		ProofMomentum: election.Proof,
+		Producers:     make([]*api.ProducerSlot, 0, len(election.Producers)),
+		Delegations:   make([]*api.DelegationSnapshot, 0, len(election.Delegations)),
+	}
+	for _, producer := range election.Producers {
+		schedule.Producers = append(schedule.Producers, &api.ProducerSlot{
+			Name:      producer.Name,
+			Producer:  producer.Producer,
+			StartTime: producer.StartTime.Unix(),
+			EndTime:   producer.EndTime.Unix(),
+		})
+	}
+	for _, delegation := range election.Delegations {
+		schedule.Delegations = append(schedule.Delegations, &api.DelegationSnapshot{
+			Name:     delegation.Name,
+			Producer: delegation.Producing,
+			Weight:   delegation.Weight,
+		})
+	}
+	return schedule, nil
+}
+func (r *scheduleReader) ConsensusParameters() []*api.ConsensusParameters {
+	return r.em.schedule.parameters()
+}
@@ -0,0 +1,169 @@
+package consensus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus/storage"
+)
+
+const (
+	// snapshotBatchSize is the number of consensus entries written at once by InsertSnapshotEntries
+	snapshotBatchSize = 10000
+)
+
+// snapshotFilter returns the filter of the snapshot entries of momentum
+func (cs *consensus) snapshotFilter(momentum *nom.Momentum) func(key []byte) bool {
+	periodTicks := cs.points.GetPeriodPoints().ToTick(*momentum.Timestamp)
+	epochTicks := cs.points.GetEpochPoints().ToTick(*momentum.Timestamp)
+	return func(key []byte) bool {
+		return storage.IsSnapshotEntry(key, periodTicks, epochTicks)
+	}
+}
+
+// snapshotDumper collects the entries of a snapshot in key order, starting at from, until their size reaches limit
+type snapshotDumper struct {
+	entries db.Patch
+	from    []byte
+	limit   int
+	size    int
+	next    []byte
+}
+
+// add returns false once the limit is reached, value is only called for the returned entries
+func (d *snapshotDumper) add(key []byte, value func() ([]byte, error)) (bool, error) {
+	if bytes.Compare(key, d.from) < 0 {
+		return true, nil
+	}
+	if d.size >= d.limit {
+		d.next = key
+		return false, nil
+	}
+	v, err := value()
+	if err != nil {
+		return false, err
+	}
+	d.entries.Put(key, v)
+	d.size += len(key) + len(v)
+	return true, nil
+}
+
+// firstTick returns the first tick of the points with prefix which can be dumped starting at from
+func (d *snapshotDumper) firstTick(prefix byte) uint64 {
+	if len(d.from) < 9 || d.from[0] != prefix {
+		return 0
+	}
+	return binary.BigEndian.Uint64(d.from[1:9])
+}
+
+func (d *snapshotDumper) addPoints(prefix byte, reader PointsReader, ticks uint64) (bool, error) {
+	if len(d.from) > 0 && d.from[0] > prefix {
+		return true, nil
+	}
+	for tick := d.firstTick(prefix); tick < ticks; tick += 1 {
+		if ok, err := d.add(storage.CreatePointKey(prefix, tick), func() ([]byte, error) {
+			point, err := reader.GetPoint(tick)
+			if err != nil {
+				return nil, err
+			}
+			if point == nil {
+				return nil, errors.Errorf("consensus point %v of tick %v is missing", prefix, tick)
+			}
+			return point.Marshal()
+		}); !ok || err != nil {
+			return ok, err
+		}
+	}
+	return true, nil
+}
+
+// GetSnapshotEntries returns the consensus entries which the state of momentum depends on, recomputed from the chain
+// so that all the nodes serve the same entries:
+//   - the points of the ticks completed by momentum
+//   - the election results of the tick of momentum and of the next one, whose proof momentums precede momentum
+func (cs *consensus) GetSnapshotEntries(momentum *nom.Momentum, from []byte, limit int) (db.Patch, []byte, error) {
+	d := &snapshotDumper{
+		entries: db.NewPatch(),
+		from:    from,
+		limit:   limit,
+	}
+	if ok, err := d.addPoints(storage.PrefixPeriodPoint, cs.points.GetPeriodPoints(), cs.points.GetPeriodPoints().ToTick(*momentum.Timestamp)); !ok || err != nil {
+		return d.entries, d.next, err
+	}
+	if ok, err := d.addPoints(storage.PrefixEpochPoint, cs.points.GetEpochPoints(), cs.points.GetEpochPoints().ToTick(*momentum.Timestamp)); !ok || err != nil {
+		return d.entries, d.next, err
+	}
+
+	elections := make(map[string]*storage.ElectionData)
+	keys := make([][]byte, 0, 2)
+	tick := cs.electionManager.ToTick(*momentum.Timestamp)
+	for _, t := range []uint64{tick, tick + 1} {
+		hash, data, err := cs.electionManager.electionDataByTick(t)
+		if err != nil {
+			return nil, nil, err
+		}
+		key := storage.CreateElectionResultKey(hash)
+		if _, ok := elections[string(key)]; !ok {
+			keys = append(keys, key)
+		}
+		elections[string(key)] = data
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	for _, key := range keys {
+		if ok, err := d.add(key, elections[string(key)].Marshal); !ok || err != nil {
+			return d.entries, d.next, err
+		}
+	}
+	return d.entries, nil, nil
+}
+
+// checkSnapshotEntries fails for the entries which aren't part of the snapshot of momentum
+func (cs *consensus) checkSnapshotEntries(momentum *nom.Momentum, entries db.DB) error {
+	filter := cs.snapshotFilter(momentum)
+	iterator := entries.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		// deleted entries are still iterated, with a nil value
+		if iterator.Value() != nil && !filter(iterator.Key()) {
+			return errors.Errorf("unexpected consensus entry %x", iterator.Key())
+		}
+	}
+	return iterator.Error()
+}
+
+func (cs *consensus) InsertSnapshotEntries(momentum *nom.Momentum, entries db.DB) error {
+	// all the entries are checked before any of them is stored
+	if err := cs.checkSnapshotEntries(momentum, entries); err != nil {
+		return err
+	}
+	iterator := entries.NewIterator(nil)
+	defer iterator.Release()
+
+	batch := db.NewPatch()
+	size := 0
+	for iterator.Next() {
+		if iterator.Value() == nil {
+			continue
+		}
+		batch.Put(iterator.Key(), iterator.Value())
+		size += 1
+		if size == snapshotBatchSize {
+			if err := cs.electionManager.db.Apply(batch); err != nil {
+				return err
+			}
+			batch = db.NewPatch()
+			size = 0
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	return cs.electionManager.db.Apply(batch)
+}
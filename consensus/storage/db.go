@@ -138,6 +138,63 @@ func (db *DB) StoreElectionResultByHash(hash types.Hash, data *ElectionData) err
 	return nil
 }
 
+// Clear deletes all the election results and points, for rebuilding the DB from the chain
+func (db *DB) Clear() error {
+	for _, prefix := range []byte{PrefixPeriodPoint, PrefixEpochPoint, PrefixElectionResult} {
+		keys := make([][]byte, 0)
+		iterator := db.db.NewIterator([]byte{prefix})
+		for iterator.Next() {
+			// deleted entries are still iterated, with an empty value
+			if iterator.Value() == nil {
+				continue
+			}
+			keys = append(keys, append([]byte{}, iterator.Key()...))
+		}
+		err := iterator.Error()
+		iterator.Release()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := db.db.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	db.electionCache.Purge()
+	for _, cache := range db.pointCache {
+		cache.Purge()
+	}
+	return nil
+}
+
+// IsSnapshotEntry returns true for the entries of a snapshot taken after periodTicks and epochTicks were completed:
+// the points of the completed ticks and the election results, which are identified by their proof momentum
+func IsSnapshotEntry(key []byte, periodTicks, epochTicks uint64) bool {
+	switch {
+	case len(key) == 9 && key[0] == PrefixPeriodPoint:
+		return binary.BigEndian.Uint64(key[1:9]) < periodTicks
+	case len(key) == 9 && key[0] == PrefixEpochPoint:
+		return binary.BigEndian.Uint64(key[1:9]) < epochTicks
+	case len(key) == 1+types.HashSize && key[0] == PrefixElectionResult:
+		return true
+	}
+	return false
+}
+
+// Apply stores the entries of a snapshot, see IsSnapshotEntry
+func (db *DB) Apply(entries db.Patch) error {
+	if err := db.db.Apply(entries); err != nil {
+		return err
+	}
+	db.electionCache.Purge()
+	for _, cache := range db.pointCache {
+		cache.Purge()
+	}
+	return nil
+}
+
 func CreateElectionResultKey(hash types.Hash) []byte {
 	key := make([]byte, 1+types.HashSize)
 	key[0] = PrefixElectionResult
@@ -3,6 +3,7 @@ package storage
 import (
 	"encoding/json"
 	"math/big"
+	"sort"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -46,6 +47,23 @@ func (p *Point) Json() string {
 	bytes, _ := json.Marshal(p)
 	return string(bytes)
 }
+
+// Equal compares the points by content
+func (p *Point) Equal(other *Point) bool {
+	if p.PrevHash != other.PrevHash || p.EndHash != other.EndHash || p.TotalWeight.Cmp(other.TotalWeight) != 0 {
+		return false
+	}
+	if len(p.Pillars) != len(other.Pillars) {
+		return false
+	}
+	for name, detail := range p.Pillars {
+		c, ok := other.Pillars[name]
+		if !ok || detail.ExpectedNum != c.ExpectedNum || detail.FactualNum != c.FactualNum || detail.Weight.Cmp(c.Weight) != 0 {
+			return false
+		}
+	}
+	return true
+}
 func (p *Point) Marshal() ([]byte, error) {
 	pb := &ConsensusPointProto{}
 	pb.EndHash = p.EndHash.Bytes()
@@ -61,6 +79,10 @@ func (p *Point) Marshal() ([]byte, error) {
 			c.Weight = v.Weight.Bytes()
 			pb.Content = append(pb.Content, c)
 		}
+		// the pillars are sorted so that the same points have the same bytes
+		sort.Slice(pb.Content, func(i, j int) bool {
+			return pb.Content[i].Name < pb.Content[j].Name
+		})
 	}
 	buf, err := proto.Marshal(pb)
 	if err != nil {
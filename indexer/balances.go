@@ -0,0 +1,174 @@
+package indexer
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	secondsPerDay = int64(24 * 60 * 60)
+	balanceSize   = 32
+)
+
+// BalanceChange is the balance of an address after a momentum which changed it
+type BalanceChange struct {
+	MomentumHeight uint64
+	Balance        *big.Int
+	// Blocks are the account-blocks of the address confirmed by the momentum
+	Blocks []types.Hash
+}
+
+// DailyBalance is the balance of an address at the end of a UTC day
+type DailyBalance struct {
+	// Day is the unix timestamp of the start of the day
+	Day int64
+	// MomentumHeight is the last momentum of the day
+	MomentumHeight uint64
+	Balance        *big.Int
+}
+
+// insertBalances records the balances changed by momentum for the owners of the confirmed account-blocks,
+// since the balance of an address changes only with its own account-blocks.
+// The balances of the momentums deleted by the pruning mode are not recorded.
+func (idx *indexer) insertBalances(momentum *nom.Momentum, put func(index []byte, seq []byte, value []byte)) error {
+	momentumStore, err := idx.chain.GetMomentumStore(momentum.Identifier())
+	if err == db.ErrPruned {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if momentumStore == nil {
+		return errors.Errorf("missing state of momentum %v", momentum.Identifier())
+	}
+
+	addresses := make([]types.Address, 0)
+	blocks := make(map[types.Address][]types.Hash)
+	for _, header := range momentum.Content {
+		if _, ok := blocks[header.Address]; !ok {
+			addresses = append(addresses, header.Address)
+		}
+		blocks[header.Address] = append(blocks[header.Address], header.Hash)
+	}
+
+	for _, address := range addresses {
+		balances, err := momentumStore.GetAccountStore(address).GetBalanceMap()
+		if err != nil {
+			return err
+		}
+		value := make([]byte, 0)
+		for _, hash := range blocks[address] {
+			value = append(value, hash.Bytes()...)
+		}
+		for zts, balance := range balances {
+			previous, err := idx.GetBalanceAt(address, zts, momentum.Height-1)
+			if err != nil {
+				return err
+			}
+			if previous.Cmp(balance) == 0 {
+				continue
+			}
+			put(balanceIndex(address, zts), common.Uint64ToBytes(momentum.Height), common.JoinBytes(common.BigIntToBytes(balance), value))
+		}
+	}
+	return nil
+}
+
+func parseBalanceChange(key, value []byte) (*BalanceChange, error) {
+	if len(value) < balanceSize || (len(value)-balanceSize)%types.HashSize != 0 {
+		return nil, errInvalidRecord
+	}
+	change := &BalanceChange{
+		MomentumHeight: common.BytesToUint64(key[len(key)-8:]),
+		Balance:        common.BytesToBigInt(value[:balanceSize]),
+		Blocks:         make([]types.Hash, 0),
+	}
+	for data := value[balanceSize:]; len(data) > 0; data = data[types.HashSize:] {
+		change.Blocks = append(change.Blocks, types.BytesToHashPanic(data[:types.HashSize]))
+	}
+	return change, nil
+}
+
+func (idx *indexer) GetBalanceAt(address types.Address, zts types.ZenonTokenStandard, height uint64) (*big.Int, error) {
+	index := balanceIndex(address, zts)
+	iterator := idx.db.NewIterator(&util.Range{
+		Start: index,
+		Limit: common.JoinBytes(index, common.Uint64ToBytes(height+1)),
+	}, nil)
+	defer iterator.Release()
+	if !iterator.Last() {
+		return big.NewInt(0), iterator.Error()
+	}
+	change, err := parseBalanceChange(iterator.Key(), iterator.Value())
+	if err != nil {
+		return nil, err
+	}
+	return change.Balance, nil
+}
+
+func (idx *indexer) GetBalanceHistory(address types.Address, zts types.ZenonTokenStandard, fromHeight, toHeight uint64, count int) ([]*BalanceChange, bool, error) {
+	if frontier := idx.Frontier().Height; toHeight > frontier {
+		toHeight = frontier
+	}
+	changes := make([]*BalanceChange, 0)
+	if fromHeight > toHeight {
+		return changes, false, nil
+	}
+
+	index := balanceIndex(address, zts)
+	iterator := idx.db.NewIterator(&util.Range{
+		Start: common.JoinBytes(index, common.Uint64ToBytes(fromHeight)),
+		Limit: common.JoinBytes(index, common.Uint64ToBytes(toHeight+1)),
+	}, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		if len(changes) == count {
+			return changes, true, nil
+		}
+		change, err := parseBalanceChange(iterator.Key(), iterator.Value())
+		if err != nil {
+			return nil, false, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, false, iterator.Error()
+}
+
+func (idx *indexer) GetDailyBalances(address types.Address, zts types.ZenonTokenStandard, fromTimestamp, toTimestamp int64) ([]*DailyBalance, error) {
+	balances := make([]*DailyBalance, 0)
+	if fromTimestamp < 0 {
+		fromTimestamp = 0
+	}
+	lastTimestamp, _, ok, err := idx.lastMomentumBefore(^uint64(0))
+	if err != nil || !ok {
+		return balances, err
+	}
+
+	for day := fromTimestamp - fromTimestamp%secondsPerDay; day <= toTimestamp && uint64(day) <= lastTimestamp; day += secondsPerDay {
+		_, height, ok, err := idx.lastMomentumBefore(uint64(day + secondsPerDay))
+		if err != nil {
+			return nil, err
+		}
+		// the day is before the genesis momentum
+		if !ok {
+			continue
+		}
+		balance, err := idx.GetBalanceAt(address, zts, height)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, &DailyBalance{
+			Day:            day,
+			MomentumHeight: height,
+			Balance:        balance,
+		})
+	}
+	return balances, nil
+}
@@ -0,0 +1,285 @@
+package indexer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/abi"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+// embeddedABIs are used to decode the methods called on the embedded contracts
+var embeddedABIs = map[types.Address]abi.ABIContract{
+	types.PillarContract:      definition.ABIPillars,
+	types.PlasmaContract:      definition.ABIPlasma,
+	types.StakeContract:       definition.ABIStake,
+	types.SporkContract:       definition.ABISpork,
+	types.TokenContract:       definition.ABIToken,
+	types.SentinelContract:    definition.ABISentinel,
+	types.SwapContract:        definition.ABISwap,
+	types.LiquidityContract:   definition.ABILiquidity,
+	types.AcceleratorContract: definition.ABIAccelerator,
+	types.HtlcContract:        definition.ABIHtlc,
+	types.BridgeContract:      definition.ABIBridge,
+	types.AuthorityContract:   definition.ABIAuthority,
+}
+
+// Indexer keeps secondary indexes of the confirmed account-blocks and the balance history of the addresses in its own DB.
+// The indexes are built in the background and follow the frontier momentum, including rollbacks.
+type Indexer interface {
+	// MomentumEventListener is used to wake up the background worker as momentums come
+	chain.MomentumEventListener
+	Init() error
+	Start() error
+	Stop() error
+
+	// Frontier returns the last indexed momentum
+	Frontier() types.HashHeight
+	// GetBalanceAt returns the balance of address confirmed by the momentum at height
+	GetBalanceAt(address types.Address, zts types.ZenonTokenStandard, height uint64) (*big.Int, error)
+	// GetBalanceHistory returns at most count balance changes of address between fromHeight and toHeight included,
+	// and whether there are more changes after the last returned one
+	GetBalanceHistory(address types.Address, zts types.ZenonTokenStandard, fromHeight, toHeight uint64, count int) ([]*BalanceChange, bool, error)
+	// GetDailyBalances returns the balance of address confirmed by the last momentum of each UTC day
+	// between fromTimestamp and toTimestamp, up to the last indexed momentum
+	GetDailyBalances(address types.Address, zts types.ZenonTokenStandard, fromTimestamp, toTimestamp int64) ([]*DailyBalance, error)
+	// SearchAccountBlocks returns the hashes of at most count account-blocks matching filter, in confirmation order,
+	// starting after cursor. The returned cursor continues the search and is nil once the search reached the last indexed momentum.
+	SearchAccountBlocks(filter *AccountBlockFilter, cursor []byte, count uint64) ([]types.Hash, []byte, error)
+}
+
+type indexer struct {
+	log   common.Logger
+	chain chain.Chain
+	db    *leveldb.DB
+
+	frontier types.HashHeight
+	changes  sync.RWMutex
+
+	changed chan struct{}
+	stopped chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewIndexer builds the indexes of ch inside db, which is closed by Stop
+func NewIndexer(ch chain.Chain, db *leveldb.DB) Indexer {
+	return &indexer{
+		log:     common.IndexerLogger,
+		chain:   ch,
+		db:      db,
+		changed: make(chan struct{}, 1),
+		stopped: make(chan struct{}),
+	}
+}
+
+func (idx *indexer) Init() error {
+	data, err := idx.db.Get(frontierKey, nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	frontier, err := types.DeserializeHashHeight(data)
+	if err != nil {
+		return err
+	}
+	idx.frontier = *frontier
+	return nil
+}
+func (idx *indexer) Start() error {
+	idx.log.Info("start", "frontier", idx.Frontier())
+	idx.chain.Register(idx)
+	idx.wg.Add(1)
+	go func() {
+		defer idx.wg.Done()
+		idx.work()
+	}()
+	return nil
+}
+func (idx *indexer) Stop() error {
+	idx.log.Info("stop", "frontier", idx.Frontier())
+	idx.chain.UnRegister(idx)
+	close(idx.stopped)
+	idx.wg.Wait()
+	return idx.db.Close()
+}
+
+func (idx *indexer) InsertMomentum(*nom.DetailedMomentum) {
+	idx.wakeUp()
+}
+func (idx *indexer) DeleteMomentum(*nom.DetailedMomentum) {
+	idx.wakeUp()
+}
+func (idx *indexer) wakeUp() {
+	select {
+	case idx.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (idx *indexer) Frontier() types.HashHeight {
+	idx.changes.RLock()
+	defer idx.changes.RUnlock()
+	return idx.frontier
+}
+func (idx *indexer) setFrontier(batch *leveldb.Batch, frontier types.HashHeight) error {
+	batch.Put(frontierKey, frontier.Serialize())
+	idx.changes.Lock()
+	defer idx.changes.Unlock()
+	if err := idx.db.Write(batch, nil); err != nil {
+		return err
+	}
+	idx.frontier = frontier
+	return nil
+}
+
+func (idx *indexer) work() {
+	for {
+		if err := idx.update(); err != nil {
+			idx.log.Error("failed to update the indexes", "reason", err)
+		}
+		select {
+		case <-idx.stopped:
+			return
+		case <-idx.changed:
+		}
+	}
+}
+
+// update indexes the momentums up to the frontier momentum, after removing the momentums rolled back by the chain
+func (idx *indexer) update() error {
+	momentumStore := idx.chain.GetFrontierMomentumStore()
+	target := momentumStore.Identifier()
+	for {
+		select {
+		case <-idx.stopped:
+			return nil
+		default:
+		}
+
+		current := idx.Frontier()
+		if current.Height != 0 {
+			momentum, err := momentumStore.GetMomentumByHeight(current.Height)
+			if err != nil {
+				return err
+			}
+			if momentum == nil || momentum.Hash != current.Hash {
+				if err := idx.deleteMomentum(current); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if current.Height >= target.Height {
+			return nil
+		}
+
+		momentum, err := momentumStore.GetMomentumByHeight(current.Height + 1)
+		if err != nil {
+			return err
+		}
+		if err := idx.insertMomentum(momentumStore, momentum); err != nil {
+			return err
+		}
+	}
+}
+
+func (idx *indexer) insertMomentum(momentumStore store.Momentum, momentum *nom.Momentum) error {
+	batch := new(leveldb.Batch)
+	r := &record{
+		hash: momentum.Hash,
+		keys: make([][]byte, 0),
+	}
+	put := func(index []byte, seq []byte, value []byte) {
+		key := append(index, seq...)
+		batch.Put(key, value)
+		r.keys = append(r.keys, key)
+	}
+
+	put(timeKey(momentum.TimestampUnix, momentum.Height), nil, nil)
+	for position, header := range momentum.Content {
+		block, err := momentumStore.GetAccountBlock(*header)
+		if err != nil {
+			return err
+		}
+		// the body was deleted by the pruning mode
+		if block == nil {
+			continue
+		}
+		seq := sequence(momentum.Height, uint32(position))
+		value := block.Hash.Bytes()
+
+		put(blockIndex(), seq, value)
+		put(addressIndex(block.Address), seq, value)
+
+		counterparty, zts, amount := block.ToAddress, block.TokenStandard, block.Amount
+		if block.IsReceiveBlock() {
+			sendBlock, err := momentumStore.GetAccountBlockByHash(block.FromBlockHash)
+			if err != nil {
+				return err
+			}
+			if sendBlock == nil {
+				counterparty, zts, amount = types.ZeroAddress, types.ZeroTokenStandard, nil
+			} else {
+				counterparty, zts, amount = sendBlock.Address, sendBlock.TokenStandard, sendBlock.Amount
+			}
+		}
+		if !counterparty.IsZero() {
+			put(counterpartyIndex(counterparty), seq, value)
+		}
+		if amount != nil && amount.Sign() > 0 {
+			put(tokenIndex(zts), seq, value)
+		}
+		if block.IsSendBlock() {
+			if contractAbi, ok := embeddedABIs[block.ToAddress]; ok {
+				if method, err := contractAbi.MethodById(block.Data); err == nil {
+					put(methodIndex(block.ToAddress, method.Id()), seq, value)
+				}
+			}
+		}
+	}
+
+	if err := idx.insertBalances(momentum, put); err != nil {
+		return err
+	}
+
+	batch.Put(recordKey(momentum.Height), r.serialize())
+	return idx.setFrontier(batch, momentum.Identifier())
+}
+func (idx *indexer) deleteMomentum(identifier types.HashHeight) error {
+	r, err := idx.getRecord(identifier.Height)
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	for _, key := range r.keys {
+		batch.Delete(key)
+	}
+	batch.Delete(recordKey(identifier.Height))
+
+	previous := types.ZeroHashHeight
+	if identifier.Height > 1 {
+		previousRecord, err := idx.getRecord(identifier.Height - 1)
+		if err != nil {
+			return err
+		}
+		previous = types.HashHeight{Hash: previousRecord.hash, Height: identifier.Height - 1}
+	}
+	idx.log.Info("removed momentum from the indexes", "identifier", identifier)
+	return idx.setFrontier(batch, previous)
+}
+func (idx *indexer) getRecord(height uint64) (*record, error) {
+	data, err := idx.db.Get(recordKey(height), nil)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeRecord(data)
+}
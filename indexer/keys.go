@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	frontierPrefix = byte(0)
+	recordPrefix   = byte(1)
+	timePrefix     = byte(2)
+
+	blockPrefix        = byte(10)
+	addressPrefix      = byte(11)
+	tokenPrefix        = byte(12)
+	counterpartyPrefix = byte(13)
+	methodPrefix       = byte(14)
+
+	balancePrefix = byte(20)
+
+	// sequenceSize is the size of the suffix shared by all account-block index keys,
+	// the momentum height followed by the position of the account-block in the momentum content
+	sequenceSize = 8 + 4
+)
+
+var (
+	frontierKey = []byte{frontierPrefix}
+
+	errInvalidRecord = errors.New("invalid index record")
+)
+
+func sequence(height uint64, position uint32) []byte {
+	return common.JoinBytes(common.Uint64ToBytes(height), common.Uint32ToBytes(position))
+}
+
+func recordKey(height uint64) []byte {
+	return common.JoinBytes([]byte{recordPrefix}, common.Uint64ToBytes(height))
+}
+func timeKey(timestamp uint64, height uint64) []byte {
+	return common.JoinBytes([]byte{timePrefix}, common.Uint64ToBytes(timestamp), common.Uint64ToBytes(height))
+}
+
+func blockIndex() []byte {
+	return []byte{blockPrefix}
+}
+func addressIndex(address types.Address) []byte {
+	return common.JoinBytes([]byte{addressPrefix}, address.Bytes())
+}
+func tokenIndex(zts types.ZenonTokenStandard) []byte {
+	return common.JoinBytes([]byte{tokenPrefix}, zts.Bytes())
+}
+func counterpartyIndex(address types.Address) []byte {
+	return common.JoinBytes([]byte{counterpartyPrefix}, address.Bytes())
+}
+func methodIndex(contract types.Address, methodId []byte) []byte {
+	return common.JoinBytes([]byte{methodPrefix}, contract.Bytes(), methodId)
+}
+
+// balanceIndex is followed by the momentum height, a single balance change is stored for each momentum
+func balanceIndex(address types.Address, zts types.ZenonTokenStandard) []byte {
+	return common.JoinBytes([]byte{balancePrefix}, address.Bytes(), zts.Bytes())
+}
+
+// record is stored for each indexed momentum with all the keys written for it,
+// so that a rollback deletes them without the rolled back account-blocks
+type record struct {
+	hash types.Hash
+	keys [][]byte
+}
+
+func (r *record) serialize() []byte {
+	data := r.hash.Bytes()
+	for _, key := range r.keys {
+		data = append(data, byte(len(key)))
+		data = append(data, key...)
+	}
+	return data
+}
+func deserializeRecord(data []byte) (*record, error) {
+	if len(data) < types.HashSize {
+		return nil, errInvalidRecord
+	}
+	r := &record{
+		hash: types.BytesToHashPanic(data[:types.HashSize]),
+		keys: make([][]byte, 0),
+	}
+	for data = data[types.HashSize:]; len(data) > 0; {
+		size := int(data[0])
+		if len(data) < 1+size {
+			return nil, errInvalidRecord
+		}
+		r.keys = append(r.keys, data[1:1+size])
+		data = data[1+size:]
+	}
+	return r, nil
+}
@@ -0,0 +1,180 @@
+package indexer
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	// searchMaxScan limits the index keys read by a search, a search which reaches it returns a cursor to continue
+	searchMaxScan = 10000
+)
+
+var (
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrUnknownMethod    = errors.New("unknown embedded contract method")
+	ErrMethodIncomplete = errors.New("the method filter requires both the contract and the method name")
+)
+
+// AccountBlockFilter selects the account-blocks returned by a search, the empty fields match all account-blocks.
+type AccountBlockFilter struct {
+	// Address is the owner of the account-block
+	Address *types.Address `json:"address"`
+	// Counterparty is the ToAddress of a send block or the sender of the block received by a receive block
+	Counterparty *types.Address `json:"counterparty"`
+	// TokenStandard matches the blocks which send or receive a non-zero amount of the token
+	TokenStandard *types.ZenonTokenStandard `json:"tokenStandard"`
+	// Contract and Method match the send blocks which call the method of an embedded contract
+	Contract *types.Address `json:"contract"`
+	Method   string         `json:"method"`
+	// FromTimestamp and ToTimestamp bound the timestamps of the confirmation momentums, in unix seconds
+	FromTimestamp int64 `json:"fromTimestamp"`
+	ToTimestamp   int64 `json:"toTimestamp"`
+}
+
+// indexes returns the index prefixes which must all contain the account-block, the most selective one first
+func (filter *AccountBlockFilter) indexes() ([][]byte, error) {
+	indexes := make([][]byte, 0)
+	if filter.Contract != nil || len(filter.Method) != 0 {
+		if filter.Contract == nil || len(filter.Method) == 0 {
+			return nil, ErrMethodIncomplete
+		}
+		contractAbi, ok := embeddedABIs[*filter.Contract]
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownMethod, "%v is not an embedded contract", filter.Contract)
+		}
+		method, ok := contractAbi.Methods[filter.Method]
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownMethod, "%v of %v", filter.Method, filter.Contract)
+		}
+		indexes = append(indexes, methodIndex(*filter.Contract, method.Id()))
+	}
+	if filter.Counterparty != nil {
+		indexes = append(indexes, counterpartyIndex(*filter.Counterparty))
+	}
+	if filter.Address != nil {
+		indexes = append(indexes, addressIndex(*filter.Address))
+	}
+	if filter.TokenStandard != nil {
+		indexes = append(indexes, tokenIndex(*filter.TokenStandard))
+	}
+	if len(indexes) == 0 {
+		indexes = append(indexes, blockIndex())
+	}
+	return indexes, nil
+}
+
+// firstMomentumFrom returns the first indexed momentum with a timestamp not lower than timestamp
+func (idx *indexer) firstMomentumFrom(timestamp uint64) (uint64, uint64, bool, error) {
+	iterator := idx.db.NewIterator(&util.Range{
+		Start: timeKey(timestamp, 0),
+		Limit: []byte{timePrefix + 1},
+	}, nil)
+	defer iterator.Release()
+	if !iterator.First() {
+		return 0, 0, false, iterator.Error()
+	}
+	return common.BytesToUint64(iterator.Key()[1:9]), common.BytesToUint64(iterator.Key()[9:]), true, nil
+}
+
+// lastMomentumBefore returns the last indexed momentum with a timestamp lower than timestamp
+func (idx *indexer) lastMomentumBefore(timestamp uint64) (uint64, uint64, bool, error) {
+	iterator := idx.db.NewIterator(&util.Range{
+		Start: []byte{timePrefix},
+		Limit: timeKey(timestamp, 0),
+	}, nil)
+	defer iterator.Release()
+	if !iterator.Last() {
+		return 0, 0, false, iterator.Error()
+	}
+	return common.BytesToUint64(iterator.Key()[1:9]), common.BytesToUint64(iterator.Key()[9:]), true, nil
+}
+
+// heights returns the range of momentum heights confirmed inside the timestamps of filter, to is exclusive
+func (idx *indexer) heights(filter *AccountBlockFilter) (uint64, uint64, error) {
+	from, to := uint64(1), idx.Frontier().Height+1
+	if filter.FromTimestamp > 0 {
+		_, height, ok, err := idx.firstMomentumFrom(uint64(filter.FromTimestamp))
+		if err != nil || !ok {
+			return 0, 0, err
+		}
+		from = height
+	}
+	if filter.ToTimestamp > 0 {
+		_, height, ok, err := idx.lastMomentumBefore(uint64(filter.ToTimestamp) + 1)
+		if err != nil || !ok {
+			return 0, 0, err
+		}
+		to = height + 1
+	}
+	return from, to, nil
+}
+
+func (idx *indexer) SearchAccountBlocks(filter *AccountBlockFilter, cursor []byte, count uint64) ([]types.Hash, []byte, error) {
+	if filter == nil {
+		filter = &AccountBlockFilter{}
+	}
+	if len(cursor) != 0 && len(cursor) != sequenceSize {
+		return nil, nil, ErrInvalidCursor
+	}
+	indexes, err := filter.indexes()
+	if err != nil {
+		return nil, nil, err
+	}
+	from, to, err := idx.heights(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]types.Hash, 0, count)
+	if from >= to || count == 0 {
+		return hashes, nil, nil
+	}
+
+	// the keys of the cursor and below are skipped, since the sequences have a fixed size
+	start := sequence(from, 0)
+	if len(cursor) != 0 && bytes.Compare(cursor, start) >= 0 {
+		start = append(common.JoinBytes(cursor), 0)
+	}
+	primary := indexes[0]
+	iterator := idx.db.NewIterator(&util.Range{
+		Start: common.JoinBytes(primary, start),
+		Limit: common.JoinBytes(primary, sequence(to, 0)),
+	}, nil)
+	defer iterator.Release()
+
+	for scanned := 0; iterator.Next(); scanned += 1 {
+		seq := iterator.Key()[len(primary):]
+		if scanned == searchMaxScan {
+			return hashes, cursor, nil
+		}
+
+		matches := true
+		for _, index := range indexes[1:] {
+			if ok, err := idx.db.Has(common.JoinBytes(index, seq), nil); err != nil {
+				return nil, nil, err
+			} else if !ok {
+				matches = false
+				break
+			}
+		}
+		cursor = common.JoinBytes(seq)
+		if !matches {
+			continue
+		}
+
+		hash, err := types.BytesToHash(iterator.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+		hashes = append(hashes, hash)
+		if uint64(len(hashes)) == count {
+			return hashes, cursor, nil
+		}
+	}
+	return hashes, nil, iterator.Error()
+}
@@ -6,12 +6,16 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
 
+	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/chain/genesis"
 	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/metadata"
 	"github.com/zenon-network/go-zenon/p2p"
+	"github.com/zenon-network/go-zenon/protocol"
 	"github.com/zenon-network/go-zenon/wallet"
 	"github.com/zenon-network/go-zenon/zenon"
 )
@@ -47,6 +51,10 @@ type NetConfig struct {
 	MaxPendingPeers   int
 
 	Seeders []string
+
+	// SnapshotCheckpoint enables the snapshot sync: new nodes install the state of the checkpoint served by the peers
+	// instead of executing the history before it. The checkpoint has the format height:hash:digest, see protocol.SnapshotCheckpoint
+	SnapshotCheckpoint string
 }
 
 type Config struct {
@@ -58,6 +66,18 @@ type Config struct {
 
 	LogLevel string // "debug", "dbug" | "info" | "warn" | "error", "error" | "crit"
 
+	// Pruning is the number of momentums kept by the pruning mode, 0 keeps the whole history.
+	// Older states and the account-blocks they confirmed are deleted.
+	Pruning uint64
+	// FinalityDepth is the maximum number of momentums removed by the rollback command
+	FinalityDepth uint64
+	// DBBackend is the storage of the chain DB, "leveldb" or "pebble".
+	// The pebble DB is stored in DataPath/nom-pebble, use the db migrate command to copy a leveldb DB into it.
+	DBBackend string
+	// Indexer builds the account-block search indexes and the balance history in DataPath/index,
+	// used by ledger.searchAccountBlocks, ledger.getBalanceHistory and ledger.getDailyBalances
+	Indexer bool
+
 	Producer *ProducerConfig
 	RPC      RPCConfig
 	Net      NetConfig
@@ -102,15 +122,74 @@ func (c *Config) makeZenonConfig(walletManager *wallet.Manager) (*zenon.Config,
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkPruning(); err != nil {
+		return nil, err
+	}
+	if err := db.CheckBackend(c.DBBackend); err != nil {
+		return nil, err
+	}
+	var snapshotCheckpoint *protocol.SnapshotCheckpoint
+	if c.Net.SnapshotCheckpoint != "" {
+		if snapshotCheckpoint, err = protocol.ParseSnapshotCheckpoint(c.Net.SnapshotCheckpoint); err != nil {
+			return nil, err
+		}
+	}
 
 	return &zenon.Config{
-		MinPeers:          c.Net.MinPeers,
-		MinConnectedPeers: c.Net.MinConnectedPeers,
-		ProducingKeyPair:  pillarCoinbase,
-		GenesisConfig:     c.makeGenesisConfig(),
-		DataDir:           c.DataPath,
+		MinPeers:           c.Net.MinPeers,
+		MinConnectedPeers:  c.Net.MinConnectedPeers,
+		ProducingKeyPair:   pillarCoinbase,
+		GenesisConfig:      c.makeGenesisConfig(),
+		DataDir:            c.DataPath,
+		Pruning:            c.Pruning,
+		SnapshotCheckpoint: snapshotCheckpoint,
+		DBBackend:          c.DBBackend,
+		Indexer:            c.Indexer,
 	}, nil
 }
+
+func (c *Config) checkPruning() error {
+	if c.Pruning != 0 && c.Pruning < chain.MinPruningRetention {
+		return errors.Errorf("pruning must keep at least %v momentums but got %v", chain.MinPruningRetention, c.Pruning)
+	}
+	return nil
+}
+
+// OpenChain opens the chain stored in DataPath without starting the node, for the commands which work offline.
+// The caller must stop the returned chain.
+func (c *Config) OpenChain() (chain.Chain, error) {
+	if err := c.checkPruning(); err != nil {
+		return nil, err
+	}
+	if err := db.CheckBackend(c.DBBackend); err != nil {
+		return nil, err
+	}
+	zenonConfig := &zenon.Config{
+		DataDir:       c.DataPath,
+		GenesisConfig: c.makeGenesisConfig(),
+		Pruning:       c.Pruning,
+		DBBackend:     c.DBBackend,
+	}
+	ch := chain.NewPrunedChain(zenonConfig.NewDBManager("nom"), zenonConfig.GenesisConfig, zenonConfig.Pruning)
+	if err := ch.Init(); err != nil {
+		if stopErr := ch.Stop(); stopErr != nil {
+			log.Error("failed to stop chain", "reason", stopErr)
+		}
+		return nil, err
+	}
+	return ch, nil
+}
+
+// OpenConsensusDB opens the consensus DB stored in DataPath without starting the node, for the commands which work offline.
+// Fails if the DB is used by a running node. The caller must close the returned leveldb.
+func (c *Config) OpenConsensusDB() (db.DB, *leveldb.DB, error) {
+	ldb, err := leveldb.OpenFile(filepath.Join(c.DataPath, "consensus"), nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "can't open consensus DB")
+	}
+	return db.NewLevelDBWrapper(ldb), ldb, nil
+}
+
 func (c *Config) makeGenesisConfig() (genesisConfig store.Genesis) {
 	var err error
 	var path string
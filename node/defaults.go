@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"runtime"
 
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/p2p"
 )
 
@@ -20,6 +22,10 @@ var DefaultNodeConfig = Config{
 
 	LogLevel: "info",
 
+	FinalityDepth: chain.DefaultFinalityDepth,
+
+	DBBackend: db.LevelDBBackend,
+
 	RPC: RPCConfig{
 		HTTPPort:   p2p.DefaultHTTPPort,
 		HTTPHost:   "0.0.0.0",
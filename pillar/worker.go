@@ -33,9 +33,16 @@ type worker struct {
 }
 
 func newWorker(chain chain.Chain, supervisor *vm.Supervisor, broadcaster protocol.Broadcaster) *worker {
+	// send blocks to disabled contracts are rejected, so there is nothing to receive for them
+	contracts := make([]types.Address, 0, len(types.EmbeddedContracts))
+	for _, address := range types.EmbeddedContracts {
+		if chain.GetChainParams().IsContractEnabled(address) {
+			contracts = append(contracts, address)
+		}
+	}
 	return &worker{
 		log:         common.PillarLogger.New("submodule", "worker"),
-		contracts:   types.EmbeddedContracts,
+		contracts:   contracts,
 		supervisor:  supervisor,
 		chain:       chain,
 		broadcaster: broadcaster,
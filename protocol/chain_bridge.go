@@ -6,8 +6,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/momentum"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus"
 	"github.com/zenon-network/go-zenon/verifier"
@@ -83,6 +85,10 @@ func (c chainBridge) GetBlock(hash types.Hash) *nom.DetailedMomentum {
 
 	for i := range prefetched {
 		block, _ := store.GetAccountBlock(*momentum.Content[i])
+		// the account-blocks of old momentums may be deleted by the pruning mode
+		if block == nil {
+			return nil
+		}
 		prefetched[i] = block
 	}
 
@@ -211,3 +217,71 @@ func (c chainBridge) InsertChain(momentums []*nom.DetailedMomentum) (int, error)
 
 	return 0, nil
 }
+
+func (c chainBridge) GetSnapshotManifest(identifier types.HashHeight) *SnapshotManifest {
+	store := c.chain.GetFrontierMomentumStore()
+	if store.Identifier().Height < identifier.Height+snapshotDepth {
+		return &SnapshotManifest{}
+	}
+	momentum, err := store.GetMomentumByHeight(identifier.Height)
+	if err != nil || momentum == nil || momentum.Hash != identifier.Hash {
+		return &SnapshotManifest{}
+	}
+	// pruned nodes don't have the bodies of the old account-blocks
+	if _, _, err := c.chain.GetStateEntries(identifier, nil, 0); err != nil {
+		return &SnapshotManifest{}
+	}
+	return &SnapshotManifest{
+		Height: momentum.Height,
+		Hash:   momentum.Hash,
+	}
+}
+func (c chainBridge) GetSnapshotChunk(identifier types.HashHeight, space SnapshotSpace, from []byte) (*SnapshotChunk, error) {
+	var entries db.Patch
+	var next []byte
+	var err error
+	switch space {
+	case SnapshotState:
+		entries, next, err = c.chain.GetStateEntries(identifier, from, snapshotChunkSize)
+	case SnapshotConsensus:
+		var momentum *nom.Momentum
+		momentum, err = c.chain.GetFrontierMomentumStore().GetMomentumByHeight(identifier.Height)
+		if err != nil {
+			return nil, err
+		}
+		if momentum == nil || momentum.Hash != identifier.Hash {
+			return nil, errors.Errorf("unknown momentum %v", identifier)
+		}
+		entries, next, err = c.consensus.GetSnapshotEntries(momentum, from, snapshotChunkSize)
+	default:
+		return nil, errors.Errorf("unknown snapshot space %v", space)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotChunk{
+		Entries: entries.Dump(),
+		Next:    next,
+	}, nil
+}
+func (c chainBridge) InsertSnapshot(identifier types.HashHeight, state db.DB, consensusEntries db.DB) error {
+	if err := verifier.VerifySnapshot(c.chain, state, identifier); err != nil {
+		log.Error("invalid snapshot", "reason", err, "snapshot-identifier", identifier)
+		return err
+	}
+	snapshotMomentum, err := momentum.NewStore(c.chain, state).GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+
+	insert := c.chain.AcquireInsert(fmt.Sprintf("Insert snapshot in chain-bridge. Identifier:%v", identifier))
+	defer insert.Unlock()
+	if frontier := c.chain.GetFrontierMomentumStore().Identifier(); frontier.Height > 1 {
+		return errors.Errorf("can't insert snapshot %v over the frontier momentum %v", identifier, frontier)
+	}
+	// the consensus entries are deterministic, so they are stored first and kept if the installation fails
+	if err := c.consensus.InsertSnapshotEntries(snapshotMomentum, consensusEntries); err != nil {
+		return err
+	}
+	return c.chain.InstallState(insert, state)
+}
@@ -46,6 +46,10 @@ type ProtocolManager struct {
 	fetcher    *fetcher.Fetcher
 	peers      *peerSet
 
+	// snapshotConfig enables the snapshot sync of new nodes if it's not nil, see syncSnapshot
+	snapshotConfig *SnapshotConfig
+	snapshot       snapshotSync
+
 	SubProtocols []p2p.Protocol
 
 	// channels for fetcher, syncer, txsyncLoop
@@ -61,17 +65,18 @@ type ProtocolManager struct {
 
 // NewProtocolManager returns a new ethereum sub protocol manager. The Ethereum sub protocol manages peers capable
 // with the ethereum network.
-func NewProtocolManager(minPeers int, networkId uint64, bridge ChainBridge) *ProtocolManager {
+func NewProtocolManager(minPeers int, networkId uint64, bridge ChainBridge, snapshotConfig *SnapshotConfig) *ProtocolManager {
 	// Create the protocol manager with the base fields
 	manager := &ProtocolManager{
-		minPeers:  minPeers,
-		txpool:    bridge,
-		chainman:  bridge,
-		peers:     newPeerSet(),
-		newPeerCh: make(chan *peer, 1),
-		txsyncCh:  make(chan *txsync),
-		quitSync:  make(chan struct{}),
-		netId:     int(networkId),
+		minPeers:       minPeers,
+		txpool:         bridge,
+		chainman:       bridge,
+		peers:          newPeerSet(),
+		newPeerCh:      make(chan *peer, 1),
+		txsyncCh:       make(chan *txsync),
+		quitSync:       make(chan struct{}),
+		netId:          int(networkId),
+		snapshotConfig: snapshotConfig,
 	}
 	// Initiate a sub-protocol for every implemented version we can handle
 	manager.SubProtocols = make([]p2p.Protocol, len(ProtocolVersions))
@@ -404,6 +409,40 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 			}
 		}
 
+	case GetSnapshotManifestMsg:
+		var request getSnapshotManifestData
+		if err := msg.Decode(&request); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		return p.SendSnapshotManifest(pm.chainman.GetSnapshotManifest(types.HashHeight{Height: request.Height, Hash: request.Hash}))
+
+	case SnapshotManifestMsg:
+		var manifest SnapshotManifest
+		if err := msg.Decode(&manifest); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		pm.snapshot.deliverManifest(p.id, &manifest)
+
+	case GetSnapshotChunkMsg:
+		var request getSnapshotChunkData
+		if err := msg.Decode(&request); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		identifier := types.HashHeight{Height: request.Height, Hash: request.Hash}
+		chunk, err := pm.chainman.GetSnapshotChunk(identifier, request.Space, request.From)
+		if err != nil {
+			log.Info("failed to get snapshot chunk", "peer-id", p.id, "identifier", identifier, "space", request.Space, "reason", err)
+			chunk = &SnapshotChunk{}
+		}
+		return p.SendSnapshotChunk(chunk)
+
+	case SnapshotChunkMsg:
+		var chunk SnapshotChunk
+		if err := msg.Decode(&chunk); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		pm.snapshot.deliverChunk(p.id, &chunk)
+
 	case TxMsg:
 		// Transactions arrived, parse all of them and deliver to the pool
 		var txs []*nom.AccountBlock
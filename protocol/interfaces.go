@@ -3,6 +3,7 @@ package protocol
 import (
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 )
 
@@ -42,6 +43,17 @@ type chainManager interface {
 	Status() (td uint64, currentBlock types.Hash, genesisBlock types.Hash)
 
 	InsertChain(chain []*nom.DetailedMomentum) (int, error)
+	snapshotManager
+}
+
+type snapshotManager interface {
+	// GetSnapshotManifest returns the manifest of the snapshot of the momentum, zero if it isn't served
+	GetSnapshotManifest(identifier types.HashHeight) *SnapshotManifest
+	// GetSnapshotChunk returns the entries of the snapshot key space of the momentum, starting at from
+	GetSnapshotChunk(identifier types.HashHeight, space SnapshotSpace, from []byte) (*SnapshotChunk, error)
+	// InsertSnapshot installs the state of the momentum together with the consensus entries.
+	// Both must be verified against a trusted checkpoint first, see DownloadSnapshot.
+	InsertSnapshot(identifier types.HashHeight, state db.DB, consensusEntries db.DB) error
 }
 
 type ChainBridge interface {
@@ -21,10 +21,10 @@ import (
 )
 
 // Supported versions of the eth protocol (first is primary).
-var ProtocolVersions = []uint{61}
+var ProtocolVersions = []uint{62, 61}
 
 // Number of implemented message corresponding to different protocol versions.
-var ProtocolLengths = []uint64{9}
+var ProtocolLengths = []uint64{13, 9}
 
 const (
 	ProtocolMaxMsgSize = 10 * 1024 * 1024 // Maximum cap on the size of a protocol message
@@ -41,6 +41,12 @@ const (
 	BlocksMsg
 	NewBlockMsg
 	GetBlockHashesFromNumberMsg
+
+	// snapshot messages, since version 62
+	GetSnapshotManifestMsg
+	SnapshotManifestMsg
+	GetSnapshotChunkMsg
+	SnapshotChunkMsg
 )
 
 type errCode int
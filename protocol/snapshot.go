@@ -0,0 +1,425 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/p2p"
+)
+
+// A snapshot is the whole state of the chain at a momentum height: the account-chains, the embedded contract storage,
+// the mailboxes and sequencers, together with the consensus points and the election results which can't be recomputed
+// without the previous states. Both are served as key ranges of their DBs, in chunks.
+//
+// The chain doesn't commit to whole states, so a syncing node only installs the snapshot of a trusted checkpoint,
+// computed by a trusted node with the snapshot-checkpoint command. The checkpoint pins the snapshot momentum and
+// the digest of every served entry, so the state and the consensus entries are verified before they are installed.
+// The history before the snapshot is unknown to the node, so it behaves as a pruned node for those heights.
+// The normal sync continues from the snapshot momentum.
+const (
+	// snapshotVersion is the first protocol version with the snapshot messages
+	snapshotVersion = 62
+
+	// snapshotDepth is the minimum distance between a served snapshot and the frontier momentum, so it's never rolled back
+	snapshotDepth = 100
+	// snapshotChunkSize limits the size of the entries sent in a chunk
+	snapshotChunkSize = 4 * 1024 * 1024
+	// snapshotTimeout is the time to wait for a response to a snapshot request
+	snapshotTimeout = 30 * time.Second
+	// snapshotDirName is the directory of the data dir which holds the downloaded snapshot until it's installed
+	snapshotDirName = "snapshot-sync"
+)
+
+// SnapshotSpace is a key space of a snapshot
+type SnapshotSpace uint8
+
+const (
+	// SnapshotState is the state of the chain
+	SnapshotState SnapshotSpace = iota
+	// SnapshotConsensus is the part of the consensus DB included in a snapshot
+	SnapshotConsensus
+)
+
+var (
+	ErrSnapshotUnavailable = errors.New("the peer doesn't serve the snapshot")
+	ErrSnapshotTimeout     = errors.New("snapshot request timed out")
+	ErrSnapshotChunkOrder  = errors.New("the peer sent a snapshot chunk outside of the requested range")
+	ErrSnapshotDigest      = errors.New("the snapshot doesn't match the digest of the checkpoint")
+)
+
+// SnapshotCheckpoint is a trusted snapshot, written as height:hash:digest
+type SnapshotCheckpoint struct {
+	Height uint64
+	Hash   types.Hash
+	// Digest commits to the entries of every snapshot space, in the order in which they are served
+	Digest types.Hash
+}
+
+func (c *SnapshotCheckpoint) Identifier() types.HashHeight {
+	return types.HashHeight{
+		Height: c.Height,
+		Hash:   c.Hash,
+	}
+}
+func (c *SnapshotCheckpoint) String() string {
+	return fmt.Sprintf("%v:%v:%v", c.Height, c.Hash, c.Digest)
+}
+
+func ParseSnapshotCheckpoint(checkpoint string) (*SnapshotCheckpoint, error) {
+	parts := strings.Split(checkpoint, ":")
+	if len(parts) != 3 {
+		return nil, errors.Errorf("expected height:hash:digest but got %q", checkpoint)
+	}
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || height < 2 {
+		return nil, errors.Errorf("invalid snapshot checkpoint height %q", parts[0])
+	}
+	momentumHash, err := types.HexToHash(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot checkpoint hash")
+	}
+	digest, err := types.HexToHash(parts[2])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot checkpoint digest")
+	}
+	return &SnapshotCheckpoint{
+		Height: height,
+		Hash:   momentumHash,
+		Digest: digest,
+	}, nil
+}
+
+// SnapshotConfig enables the snapshot sync, see syncSnapshot
+type SnapshotConfig struct {
+	Checkpoint *SnapshotCheckpoint
+	// DataDir holds the downloaded snapshot until it's installed
+	DataDir string
+}
+
+// SnapshotManifest identifies the snapshot served by a peer. It's zero if the peer doesn't serve the requested one.
+type SnapshotManifest struct {
+	Height uint64
+	Hash   types.Hash
+}
+
+func (m *SnapshotManifest) Identifier() types.HashHeight {
+	return types.HashHeight{
+		Height: m.Height,
+		Hash:   m.Hash,
+	}
+}
+
+// SnapshotChunk contains consecutive entries of a snapshot key space, as a patch dump
+type SnapshotChunk struct {
+	Entries []byte
+	// Next is the first key of the following chunk, empty after the last chunk
+	Next []byte
+}
+
+// getSnapshotManifestData is the network packet for the snapshot manifest retrieval message
+type getSnapshotManifestData struct {
+	Height uint64
+	Hash   types.Hash
+}
+
+// getSnapshotChunkData is the network packet for the snapshot chunk retrieval message.
+// The entries of the key space starting at From are requested.
+type getSnapshotChunkData struct {
+	Height uint64
+	Hash   types.Hash
+	Space  SnapshotSpace
+	From   []byte
+}
+
+// RequestSnapshotManifest asks the peer if it serves the snapshot of the momentum.
+func (p *peer) RequestSnapshotManifest(identifier types.HashHeight) error {
+	log.Info("fetching snapshot manifest", "peer-id", p.id, "identifier", identifier)
+	return p2p.Send(p.rw, GetSnapshotManifestMsg, getSnapshotManifestData{identifier.Height, identifier.Hash})
+}
+
+// RequestSnapshotChunk fetches the entries of the snapshot key space starting at from.
+func (p *peer) RequestSnapshotChunk(identifier types.HashHeight, space SnapshotSpace, from []byte) error {
+	log.Debug("fetching snapshot chunk", "peer-id", p.id, "identifier", identifier, "space", space, "from", from)
+	return p2p.Send(p.rw, GetSnapshotChunkMsg, getSnapshotChunkData{identifier.Height, identifier.Hash, space, from})
+}
+
+// SendSnapshotManifest sends the manifest of the requested snapshot to the peer.
+func (p *peer) SendSnapshotManifest(manifest *SnapshotManifest) error {
+	return p2p.Send(p.rw, SnapshotManifestMsg, manifest)
+}
+
+// SendSnapshotChunk sends a chunk of the served snapshot to the peer.
+func (p *peer) SendSnapshotChunk(chunk *SnapshotChunk) error {
+	return p2p.Send(p.rw, SnapshotChunkMsg, chunk)
+}
+
+// snapshotSync is the state of the running snapshot sync. Only the responses of its peer are delivered.
+type snapshotSync struct {
+	lock     sync.Mutex
+	peerId   string
+	manifest chan *SnapshotManifest
+	chunk    chan *SnapshotChunk
+}
+
+func (s *snapshotSync) start(peerId string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.peerId != "" {
+		return false
+	}
+	s.peerId = peerId
+	s.manifest = make(chan *SnapshotManifest, 1)
+	s.chunk = make(chan *SnapshotChunk, 1)
+	return true
+}
+func (s *snapshotSync) stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.peerId = ""
+}
+func (s *snapshotSync) running() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.peerId != ""
+}
+func (s *snapshotSync) deliverManifest(peerId string, manifest *SnapshotManifest) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.peerId != peerId {
+		return
+	}
+	select {
+	case s.manifest <- manifest:
+	default:
+	}
+}
+func (s *snapshotSync) deliverChunk(peerId string, chunk *SnapshotChunk) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.peerId != peerId {
+		return
+	}
+	select {
+	case s.chunk <- chunk:
+	default:
+	}
+}
+
+// chunkRangeChecker fails for the entries which are outside of the requested range of a chunk
+type chunkRangeChecker struct {
+	from []byte
+	next []byte
+	err  error
+}
+
+func (c *chunkRangeChecker) Put(key []byte, _ []byte) {
+	if c.err == nil && (bytes.Compare(key, c.from) < 0 || (len(c.next) != 0 && bytes.Compare(key, c.next) >= 0)) {
+		c.err = ErrSnapshotChunkOrder
+	}
+}
+func (c *chunkRangeChecker) Delete([]byte) {
+	if c.err == nil {
+		c.err = ErrSnapshotChunkOrder
+	}
+}
+
+// snapshotDigest hashes the entries of the snapshot spaces in the order in which they are served
+type snapshotDigest struct {
+	hash  hash.Hash
+	space SnapshotSpace
+}
+
+func newSnapshotDigest() *snapshotDigest {
+	return &snapshotDigest{hash: sha3.New256()}
+}
+func (d *snapshotDigest) write(data []byte) {
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(data)))
+	_, _ = d.hash.Write(length)
+	_, _ = d.hash.Write(data)
+}
+func (d *snapshotDigest) Put(key []byte, value []byte) {
+	_, _ = d.hash.Write([]byte{byte(d.space)})
+	d.write(key)
+	d.write(value)
+}
+
+// Delete is never called, the deletions are rejected by the chunkRangeChecker
+func (d *snapshotDigest) Delete([]byte) {}
+func (d *snapshotDigest) Sum() types.Hash {
+	return types.BytesToHashPanic(d.hash.Sum(nil))
+}
+
+// SnapshotFetcher returns the chunk of the snapshot key space which starts at from
+type SnapshotFetcher func(space SnapshotSpace, from []byte) (*SnapshotChunk, error)
+
+// DownloadSnapshot fetches the snapshot of the checkpoint into state and consensusEntries, chunk by chunk,
+// and fails if the fetched entries don't match the digest of the checkpoint.
+func DownloadSnapshot(checkpoint *SnapshotCheckpoint, fetch SnapshotFetcher, state db.DB, consensusEntries db.DB) error {
+	digest := newSnapshotDigest()
+	for _, space := range []SnapshotSpace{SnapshotState, SnapshotConsensus} {
+		target := state
+		if space == SnapshotConsensus {
+			target = consensusEntries
+		}
+		digest.space = space
+		if err := downloadSnapshotSpace(space, fetch, digest, target); err != nil {
+			return err
+		}
+	}
+	if digest.Sum() != checkpoint.Digest {
+		return ErrSnapshotDigest
+	}
+	return nil
+}
+
+// downloadSnapshotSpace fetches the chunks of a snapshot key space and applies their entries to target
+func downloadSnapshotSpace(space SnapshotSpace, fetch SnapshotFetcher, digest *snapshotDigest, target db.DB) error {
+	var from []byte
+	for {
+		chunk, err := fetch(space, from)
+		if err != nil {
+			return err
+		}
+		entries, err := db.NewPatchFromDump(chunk.Entries)
+		if err != nil {
+			return err
+		}
+		// every chunk but the last one makes progress
+		if len(chunk.Next) != 0 && bytes.Compare(chunk.Next, from) <= 0 {
+			return ErrSnapshotChunkOrder
+		}
+		checker := &chunkRangeChecker{from: from, next: chunk.Next}
+		if err := entries.Replay(checker); err != nil {
+			return err
+		}
+		if checker.err != nil {
+			return checker.err
+		}
+		if err := entries.Replay(digest); err != nil {
+			return err
+		}
+		if err := target.Apply(entries); err != nil {
+			return err
+		}
+		if len(chunk.Next) == 0 {
+			return nil
+		}
+		from = chunk.Next
+	}
+}
+
+// ComputeSnapshotCheckpoint returns the checkpoint of the snapshot served by bridge at height
+func ComputeSnapshotCheckpoint(bridge ChainBridge, height uint64) (*SnapshotCheckpoint, error) {
+	momentum, err := bridge.GetBlockByNumber(height)
+	if err != nil {
+		return nil, err
+	}
+	if momentum == nil {
+		return nil, errors.Errorf("can't find momentum at height %v", height)
+	}
+	digest := newSnapshotDigest()
+	for _, space := range []SnapshotSpace{SnapshotState, SnapshotConsensus} {
+		digest.space = space
+		var from []byte
+		for {
+			chunk, err := bridge.GetSnapshotChunk(momentum.Identifier(), space, from)
+			if err != nil {
+				return nil, err
+			}
+			entries, err := db.NewPatchFromDump(chunk.Entries)
+			if err != nil {
+				return nil, err
+			}
+			if err := entries.Replay(digest); err != nil {
+				return nil, err
+			}
+			if len(chunk.Next) == 0 {
+				break
+			}
+			from = chunk.Next
+		}
+	}
+	return &SnapshotCheckpoint{
+		Height: momentum.Height,
+		Hash:   momentum.Hash,
+		Digest: digest.Sum(),
+	}, nil
+}
+
+// fetchSnapshotChunk requests a chunk of the snapshot of the checkpoint from the peer
+func (pm *ProtocolManager) fetchSnapshotChunk(p *peer, identifier types.HashHeight) SnapshotFetcher {
+	return func(space SnapshotSpace, from []byte) (*SnapshotChunk, error) {
+		if err := p.RequestSnapshotChunk(identifier, space, from); err != nil {
+			return nil, err
+		}
+		select {
+		case chunk := <-pm.snapshot.chunk:
+			return chunk, nil
+		case <-time.After(snapshotTimeout):
+			return nil, ErrSnapshotTimeout
+		case <-pm.quitSync:
+			return nil, errors.Errorf("snapshot sync stopped")
+		}
+	}
+}
+
+// syncSnapshot downloads, verifies and installs the snapshot of the checkpoint served by the peer.
+// The normal sync continues from the snapshot height.
+func (pm *ProtocolManager) syncSnapshot(p *peer) error {
+	if !pm.snapshot.start(p.id) {
+		return nil
+	}
+	defer pm.snapshot.stop()
+
+	checkpoint := pm.snapshotConfig.Checkpoint
+	if err := p.RequestSnapshotManifest(checkpoint.Identifier()); err != nil {
+		return err
+	}
+	var manifest *SnapshotManifest
+	select {
+	case manifest = <-pm.snapshot.manifest:
+	case <-time.After(snapshotTimeout):
+		return ErrSnapshotTimeout
+	case <-pm.quitSync:
+		return nil
+	}
+	if manifest.Identifier() != checkpoint.Identifier() {
+		return ErrSnapshotUnavailable
+	}
+
+	// the snapshot is stored on disk, since it doesn't fit in memory, and installed only once it's complete and verified
+	dir := path.Join(pm.snapshotConfig.DataDir, snapshotDirName)
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	download, ldb := db.NewLevelDB(dir)
+	defer ldb.Close()
+	state := download.Subset([]byte{byte(SnapshotState)})
+	consensusEntries := download.Subset([]byte{byte(SnapshotConsensus)})
+
+	log.Info("starting snapshot sync", "peer-id", p.id, "checkpoint", checkpoint)
+	if err := DownloadSnapshot(checkpoint, pm.fetchSnapshotChunk(p, checkpoint.Identifier()), state, consensusEntries); err != nil {
+		return err
+	}
+	log.Info("downloaded snapshot", "peer-id", p.id, "snapshot-identifier", checkpoint.Identifier())
+	if err := pm.chainman.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries); err != nil {
+		return err
+	}
+	log.Info("finished snapshot sync", "peer-id", p.id, "frontier-identifier", pm.chainman.CurrentBlock().Identifier())
+	return nil
+}
@@ -192,6 +192,21 @@ func (pm *ProtocolManager) synchronise(peer *peer) {
 		return
 	}
 
+	// the downloader continues after the snapshot sync is done
+	if pm.snapshot.running() {
+		return
+	}
+	// a node which didn't reach the checkpoint yet has only the genesis momentum, the snapshot is retried with the next peer
+	if pm.snapshotConfig != nil && pm.chainman.CurrentBlock().Height < pm.snapshotConfig.Checkpoint.Height {
+		if peer.version < snapshotVersion {
+			return
+		}
+		if err := pm.syncSnapshot(peer); err != nil {
+			log.Info("snapshot sync failed", "peer-id", peer.id, "reason", err)
+			return
+		}
+	}
+
 	log.Debug("syncing", "peer-id", peer.Peer.ID(), "peer-height", peer.td, "our-height", pm.chainman.CurrentBlock().Height)
 	// Otherwise, try to sync with the downloader
 	pm.downloader.Synchronise(peer.id, peer.Head(), peer.Td())
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"github.com/inconshreveable/log15"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	consensusapi "github.com/zenon-network/go-zenon/consensus/api"
+	"github.com/zenon-network/go-zenon/zenon"
+)
+
+type ConsensusApi struct {
+	log      log15.Logger
+	reader   consensusapi.ElectionScheduleReader
+	liveness consensusapi.LivenessReader
+}
+
+func NewConsensusApi(z zenon.Zenon) *ConsensusApi {
+	return &ConsensusApi{
+		log:      common.RPCLogger.New("module", "consensus_api"),
+		reader:   z.Consensus().ElectionScheduleReader(),
+		liveness: z.Consensus().LivenessReader(),
+	}
+}
+
+type ScheduledSlot struct {
+	Tick uint64 `json:"tick"`
+	consensusapi.ProducerSlot
+}
+
+type ProducerSchedule struct {
+	Producer types.Address    `json:"producer"`
+	Slots    []*ScheduledSlot `json:"slots"`
+}
+
+// GetCurrentElection returns the producers of the current election tick
+func (a *ConsensusApi) GetCurrentElection() (*consensusapi.ElectionSchedule, error) {
+	tick, err := a.reader.CurrentTick()
+	if err != nil {
+		return nil, err
+	}
+	return a.reader.ElectionByTick(tick)
+}
+
+// GetNextElection returns the producers of the election tick after the current one
+func (a *ConsensusApi) GetNextElection() (*consensusapi.ElectionSchedule, error) {
+	tick, err := a.reader.CurrentTick()
+	if err != nil {
+		return nil, err
+	}
+	return a.reader.ElectionByTick(tick + 1)
+}
+
+// GetElectionByTick returns the producers of a past, the current or the next election tick
+func (a *ConsensusApi) GetElectionByTick(tick uint64) (*consensusapi.ElectionSchedule, error) {
+	return a.reader.ElectionByTick(tick)
+}
+
+// GetProducerSchedule returns the slots of the producer address in the current and the next election ticks
+func (a *ConsensusApi) GetProducerSchedule(producer types.Address) (*ProducerSchedule, error) {
+	tick, err := a.reader.CurrentTick()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ProducerSchedule{
+		Producer: producer,
+		Slots:    make([]*ScheduledSlot, 0),
+	}
+	for _, current := range []uint64{tick, tick + 1} {
+		election, err := a.reader.ElectionByTick(current)
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range election.Producers {
+			if slot.Producer == producer {
+				result.Slots = append(result.Slots, &ScheduledSlot{
+					Tick:         current,
+					ProducerSlot: *slot,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetSlotsByTick returns the slots of an election tick up to the frontier momentum and whether they were produced
+func (a *ConsensusApi) GetSlotsByTick(tick uint64) ([]*consensusapi.SlotRecord, error) {
+	return a.liveness.SlotsByTick(tick)
+}
+
+// GetPillarsLiveness returns the produced and missed slots of the pillars in the last election ticks
+func (a *ConsensusApi) GetPillarsLiveness() (*consensusapi.LivenessWindow, error) {
+	return a.liveness.LivenessWindow()
+}
+
+// GetParameters returns the genesis consensus parameters followed by the changes made by consensus sporks
+func (a *ConsensusApi) GetParameters() ([]*consensusapi.ConsensusParameters, error) {
+	return a.reader.ConsensusParameters(), nil
+}
@@ -0,0 +1,68 @@
+package embedded
+
+import (
+	"github.com/inconshreveable/log15"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon"
+)
+
+type AuthorityApi struct {
+	chain chain.Chain
+	log   log15.Logger
+}
+
+func NewAuthorityApi(z zenon.Zenon) *AuthorityApi {
+	return &AuthorityApi{
+		chain: z.Chain(),
+		log:   common.RPCLogger.New("module", "embedded_authority_api"),
+	}
+}
+
+type AuthorityList struct {
+	Count uint32                      `json:"count"`
+	List  []*definition.AuthorityInfo `json:"list"`
+}
+
+func (a *AuthorityApi) GetAll(pageIndex, pageSize uint32) (*AuthorityList, error) {
+	if pageSize > api.RpcMaxPageSize {
+		return nil, api.ErrPageSizeParamTooBig
+	}
+
+	_, context, err := api.GetFrontierContext(a.chain, types.AuthorityContract)
+	if err != nil {
+		return nil, err
+	}
+
+	authorities, err := definition.GetAuthorityList(context.Storage())
+	if err != nil {
+		return nil, err
+	}
+
+	listLen := uint32(len(authorities))
+	start, end := api.GetRange(pageIndex, pageSize, listLen)
+	return &AuthorityList{
+		Count: listLen,
+		List:  authorities[start:end],
+	}, nil
+}
+func (a *AuthorityApi) GetAdmins() (*definition.AuthorityAdmins, error) {
+	_, context, err := api.GetFrontierContext(a.chain, types.AuthorityContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition.GetAuthorityAdmins(context.Storage())
+}
+func (a *AuthorityApi) GetProposal(id types.Hash) (*definition.AuthorityProposal, error) {
+	_, context, err := api.GetFrontierContext(a.chain, types.AuthorityContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition.GetAuthorityProposal(context.Storage(), id)
+}
@@ -37,7 +37,7 @@ func (a *BridgeApi) GetBridgeInfo() (*definition.BridgeInfoVariable, error) {
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (a *BridgeApi) GetSecurityInfo() (*definition.SecurityInfoVariable, error)
 		return nil, err
 	}
 
-	security, err := definition.GetSecurityInfoVariable(context.Storage())
+	security, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ func (a *LiquidityApi) GetSecurityInfo() (*definition.SecurityInfoVariable, erro
 		return nil, err
 	}
 
-	security, err := definition.GetSecurityInfoVariable(context.Storage())
+	security, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
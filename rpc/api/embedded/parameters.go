@@ -0,0 +1,134 @@
+package embedded
+
+import (
+	"math/big"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/zenon"
+)
+
+type ParametersApi struct {
+	chain chain.Chain
+	z     zenon.Zenon
+	cs    consensus.Consensus
+	log   log15.Logger
+}
+
+func NewParametersApi(z zenon.Zenon) *ParametersApi {
+	return &ParametersApi{
+		chain: z.Chain(),
+		z:     z,
+		cs:    z.Consensus(),
+		log:   common.RPCLogger.New("module", "embedded_parameters_api"),
+	}
+}
+
+// ContractParameters are the economic parameters of the embedded contracts used by this chain.
+// Amounts are expressed in base units and times in seconds.
+type ContractParameters struct {
+	PillarStakeAmount            string `json:"pillarStakeAmount"`
+	PillarQsrStakeBaseAmount     string `json:"pillarQsrStakeBaseAmount"`
+	PillarQsrStakeIncreaseAmount string `json:"pillarQsrStakeIncreaseAmount"`
+	PillarEpochLockTime          int64  `json:"pillarEpochLockTime"`
+	PillarEpochRevokeTime        int64  `json:"pillarEpochRevokeTime"`
+
+	SentinelZnnRegisterAmount string `json:"sentinelZnnRegisterAmount"`
+	SentinelQsrDepositAmount  string `json:"sentinelQsrDepositAmount"`
+	SentinelLockTimeWindow    int64  `json:"sentinelLockTimeWindow"`
+	SentinelRevokeTimeWindow  int64  `json:"sentinelRevokeTimeWindow"`
+
+	StakeTimeUnitSec int64  `json:"stakeTimeUnitSec"`
+	StakeTimeMinSec  int64  `json:"stakeTimeMinSec"`
+	StakeTimeMaxSec  int64  `json:"stakeTimeMaxSec"`
+	StakeMinAmount   string `json:"stakeMinAmount"`
+
+	FuseMinAmount string `json:"fuseMinAmount"`
+
+	TokenIssueAmount string `json:"tokenIssueAmount"`
+
+	ProjectCreationAmount          string `json:"projectCreationAmount"`
+	ProjectZnnMaximumFunds         string `json:"projectZnnMaximumFunds"`
+	ProjectQsrMaximumFunds         string `json:"projectQsrMaximumFunds"`
+	VoteAcceptanceThreshold        uint32 `json:"voteAcceptanceThreshold"`
+	AcceleratorProjectVotingPeriod int64  `json:"acceleratorProjectVotingPeriod"`
+}
+
+func (a *ParametersApi) GetContractParameters() (*ContractParameters, error) {
+	params := a.chain.GetChainParams()
+	return &ContractParameters{
+		PillarStakeAmount:            params.PillarStakeAmount.String(),
+		PillarQsrStakeBaseAmount:     params.PillarQsrStakeBaseAmount.String(),
+		PillarQsrStakeIncreaseAmount: params.PillarQsrStakeIncreaseAmount.String(),
+		PillarEpochLockTime:          params.PillarEpochLockTime,
+		PillarEpochRevokeTime:        params.PillarEpochRevokeTime,
+
+		SentinelZnnRegisterAmount: params.SentinelZnnRegisterAmount.String(),
+		SentinelQsrDepositAmount:  params.SentinelQsrDepositAmount.String(),
+		SentinelLockTimeWindow:    params.SentinelLockTimeWindow,
+		SentinelRevokeTimeWindow:  params.SentinelRevokeTimeWindow,
+
+		StakeTimeUnitSec: params.StakeTimeUnitSec,
+		StakeTimeMinSec:  params.StakeTimeMinSec,
+		StakeTimeMaxSec:  params.StakeTimeMaxSec,
+		StakeMinAmount:   params.StakeMinAmount.String(),
+
+		FuseMinAmount: params.FuseMinAmount.String(),
+
+		TokenIssueAmount: params.TokenIssueAmount.String(),
+
+		ProjectCreationAmount:          params.ProjectCreationAmount.String(),
+		ProjectZnnMaximumFunds:         params.ProjectZnnMaximumFunds.String(),
+		ProjectQsrMaximumFunds:         params.ProjectQsrMaximumFunds.String(),
+		VoteAcceptanceThreshold:        params.VoteAcceptanceThreshold,
+		AcceleratorProjectVotingPeriod: params.AcceleratorProjectVotingPeriod,
+	}, nil
+}
+
+// RewardEmission is the projected emission of the network rewards for an epoch, in base units.
+// The pillar amounts are the sum of the rewards per momentum, which are rounded down.
+// The cumulated amounts include every epoch from 0 up to and including this one.
+type RewardEmission struct {
+	Epoch uint64 `json:"epoch"`
+
+	Znn                  string `json:"znn"`
+	ZnnDelegation        string `json:"znnDelegation"`
+	ZnnMomentumProducing string `json:"znnMomentumProducing"`
+	ZnnSentinel          string `json:"znnSentinel"`
+	ZnnLiquidity         string `json:"znnLiquidity"`
+	CumulatedZnn         string `json:"cumulatedZnn"`
+
+	Qsr          string `json:"qsr"`
+	QsrStake     string `json:"qsrStake"`
+	QsrSentinel  string `json:"qsrSentinel"`
+	QsrLiquidity string `json:"qsrLiquidity"`
+	CumulatedQsr string `json:"cumulatedQsr"`
+}
+
+func (a *ParametersApi) GetRewardEmission(epoch uint64) (*RewardEmission, error) {
+	params := a.chain.GetChainParams()
+	delegation, producing := params.PillarRewardPerMomentum(epoch, params.Consensus.BlockTime)
+	momentums := big.NewInt(params.MomentumsPerEpoch)
+	sentinelZnn, sentinelQsr := params.SentinelRewardForEpoch(epoch)
+	liquidityZnn, liquidityQsr := params.LiquidityRewardForEpoch(epoch)
+	cumulatedZnn, cumulatedQsr := params.CumulatedNetworkRewards(epoch)
+	return &RewardEmission{
+		Epoch: epoch,
+
+		Znn:                  params.NetworkZnnRewardPerEpoch(epoch).String(),
+		ZnnDelegation:        delegation.Mul(delegation, momentums).String(),
+		ZnnMomentumProducing: producing.Mul(producing, momentums).String(),
+		ZnnSentinel:          sentinelZnn.String(),
+		ZnnLiquidity:         liquidityZnn.String(),
+		CumulatedZnn:         cumulatedZnn.String(),
+
+		Qsr:          params.NetworkQsrRewardPerEpoch(epoch).String(),
+		QsrStake:     params.StakeQsrRewardPerEpoch(epoch).String(),
+		QsrSentinel:  sentinelQsr.String(),
+		QsrLiquidity: liquidityQsr.String(),
+		CumulatedQsr: cumulatedQsr.String(),
+	}, nil
+}
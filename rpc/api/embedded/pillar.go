@@ -8,12 +8,15 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	consensusApi "github.com/zenon-network/go-zenon/consensus/api"
 	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm/constants"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 	"github.com/zenon-network/go-zenon/vm/embedded/implementation"
+	"github.com/zenon-network/go-zenon/vm/vm_context"
 	"github.com/zenon-network/go-zenon/zenon"
 )
 
@@ -183,7 +186,33 @@ func (a *PillarApi) GetAll(pageIndex, pageSize uint32) (*PillarInfoList, error)
 	if err != nil {
 		return nil, err
 	}
+	// feed information from rpc consensus cache
+	weights, stats := a.consensusCache.Get()
+	return a.getPillarList(m, context, weights, stats, pageIndex, pageSize)
+}
 
+// GetAllAt returns the pillars registered at the momentum at momentumHeight.
+// The weights are computed from the delegations at that momentum, the epoch stats are not available and are always 0.
+func (a *PillarApi) GetAllAt(momentumHeight uint64, pageIndex, pageSize uint32) (*PillarInfoList, error) {
+	if pageSize > api.RpcMaxPageSize {
+		return nil, api.ErrPageSizeParamTooBig
+	}
+
+	m, context, err := api.GetContextAt(a.chain, types.PillarContract, momentumHeight)
+	if err != nil {
+		return nil, err
+	}
+	delegations, err := context.MomentumStore().ComputePillarDelegations()
+	if err != nil {
+		return nil, err
+	}
+	weights := make(map[string]*big.Int, len(delegations))
+	for _, delegation := range delegations {
+		weights[delegation.Name] = delegation.Weight
+	}
+	return a.getPillarList(m, context, weights, nil, pageIndex, pageSize)
+}
+func (a *PillarApi) getPillarList(m *nom.Momentum, context vm_context.AccountVmContext, weights map[string]*big.Int, stats *consensusApi.EpochStats, pageIndex, pageSize uint32) (*PillarInfoList, error) {
 	// pillars
 	candidateList, err := definition.GetPillarsList(context.Storage(), true, definition.AnyPillarType)
 	if err != nil {
@@ -194,7 +223,7 @@ func (a *PillarApi) GetAll(pageIndex, pageSize uint32) (*PillarInfoList, error)
 
 	for index, pillar := range candidateList {
 		// canBeRevoked
-		canBeRevoked, revokeCooldown := implementation.PillarGetRevokeStatus(pillar, m)
+		canBeRevoked, revokeCooldown := implementation.PillarGetRevokeStatus(a.chain.GetChainParams(), pillar, m)
 
 		targetList[index] = &PillarInfo{
 			Name:                         pillar.Name,
@@ -215,8 +244,6 @@ func (a *PillarApi) GetAll(pageIndex, pageSize uint32) (*PillarInfoList, error)
 		}
 	}
 
-	// feed information from rpc consensus cache
-	weights, stats := a.consensusCache.Get()
 	if weights != nil {
 		for _, pillar := range targetList {
 			weight, ok := weights[pillar.Name]
@@ -348,7 +375,7 @@ func (a *PillarApi) GetDelegatedPillar(addr types.Address) (*GetDelegatedPillarR
 		return nil, err
 	}
 	if delegationInfo != nil {
-		balance, err := a.chain.GetFrontierMomentumStore().GetAccountStore(addr).GetBalance(types.ZnnTokenStandard)
+		balance, err := a.chain.GetFrontierMomentumStore().GetAccountStore(addr).GetBalance(a.chain.GetChainParams().ZnnTokenStandard)
 		if err != nil {
 			return nil, err
 		}
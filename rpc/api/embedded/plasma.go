@@ -16,6 +16,7 @@ import (
 	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/vm/vm_context"
 	"github.com/zenon-network/go-zenon/zenon"
 )
 
@@ -183,7 +184,7 @@ func (a *PlasmaApi) Get(address types.Address) (*PlasmaInfo, error) {
 
 	return &PlasmaInfo{
 		CurrentPlasma: available,
-		MaxPlasma:     vm.FussedAmountToPlasma(amount),
+		MaxPlasma:     vm.FussedAmountToPlasma(a.chain.GetChainParams(), amount),
 		QsrAmount:     amount,
 	}, nil
 }
@@ -196,6 +197,22 @@ func (a *PlasmaApi) GetEntriesByAddress(address types.Address, pageIndex, pageSi
 	if err != nil {
 		return nil, err
 	}
+	return getFusionEntries(context, address, pageIndex, pageSize)
+}
+
+// GetEntriesByAddressAt returns the fusion entries of address confirmed by the momentum at momentumHeight
+func (a *PlasmaApi) GetEntriesByAddressAt(address types.Address, momentumHeight uint64, pageIndex, pageSize uint32) (*FusionEntryList, error) {
+	if pageSize > api.RpcMaxPageSize {
+		return nil, api.ErrPageSizeParamTooBig
+	}
+
+	_, context, err := api.GetContextAt(a.chain, types.PlasmaContract, momentumHeight)
+	if err != nil {
+		return nil, err
+	}
+	return getFusionEntries(context, address, pageIndex, pageSize)
+}
+func getFusionEntries(context vm_context.AccountVmContext, address types.Address, pageIndex, pageSize uint32) (*FusionEntryList, error) {
 	list, amount, err := definition.GetFusionInfoListByOwner(context.Storage(), address)
 	if err != nil {
 		return nil, err
@@ -42,7 +42,7 @@ func (api *SentinelApi) toSentinelInfo(sentinel *definition.SentinelInfo) *Senti
 		return nil
 	}
 
-	canBeRevoked, revokeCooldown := implementation.GetSentinelRevokeStatus(sentinel.RegistrationTimestamp, m)
+	canBeRevoked, revokeCooldown := implementation.GetSentinelRevokeStatus(api.chain.GetChainParams(), sentinel.RegistrationTimestamp, m)
 	return &SentinelInfo{
 		Owner:                 sentinel.Owner,
 		RegistrationTimestamp: sentinel.RegistrationTimestamp,
@@ -13,6 +13,7 @@ import (
 	"github.com/zenon-network/go-zenon/consensus"
 	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/vm/vm_context"
 	"github.com/zenon-network/go-zenon/zenon"
 )
 
@@ -147,6 +148,22 @@ func (a *StakeApi) GetEntriesByAddress(address types.Address, pageIndex, pageSiz
 	if err != nil {
 		return nil, err
 	}
+	return getStakeEntries(context, address, pageIndex, pageSize)
+}
+
+// GetEntriesByAddressAt returns the stake entries of address confirmed by the momentum at momentumHeight
+func (a *StakeApi) GetEntriesByAddressAt(address types.Address, momentumHeight uint64, pageIndex, pageSize uint32) (*StakeList, error) {
+	if pageSize > api.RpcMaxPageSize {
+		return nil, api.ErrPageSizeParamTooBig
+	}
+
+	_, context, err := api.GetContextAt(a.chain, types.StakeContract, momentumHeight)
+	if err != nil {
+		return nil, err
+	}
+	return getStakeEntries(context, address, pageIndex, pageSize)
+}
+func getStakeEntries(context vm_context.AccountVmContext, address types.Address, pageIndex, pageSize uint32) (*StakeList, error) {
 	list, total, totalWeighted, err := definition.GetStakeListByAddress(context.Storage(), address)
 	if err != nil {
 		return nil, err
@@ -10,6 +10,7 @@ import (
 	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm/constants"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/vm/vm_context"
 	"github.com/zenon-network/go-zenon/zenon"
 )
 
@@ -87,6 +88,18 @@ func (a *TokenAPI) GetByZts(zts types.ZenonTokenStandard) (*api.Token, error) {
 	if err != nil {
 		return nil, err
 	}
+	return getTokenByZts(context, zts)
+}
+
+// GetByZtsAt returns the token info of zts confirmed by the momentum at momentumHeight
+func (a *TokenAPI) GetByZtsAt(zts types.ZenonTokenStandard, momentumHeight uint64) (*api.Token, error) {
+	_, context, err := api.GetContextAt(a.chain, types.TokenContract, momentumHeight)
+	if err != nil {
+		return nil, err
+	}
+	return getTokenByZts(context, zts)
+}
+func getTokenByZts(context vm_context.AccountVmContext, zts types.ZenonTokenStandard) (*api.Token, error) {
 	tokenInfo, err := definition.GetTokenInfo(context.Storage(), zts)
 	if err == constants.ErrDataNonExistent {
 		return nil, nil
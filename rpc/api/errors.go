@@ -10,4 +10,12 @@ var (
 	ErrCountParamTooBig     = common.NewErrorWCode(-32000, "count parameter is too big")
 	ErrHeightParamIsZero    = common.NewErrorWCode(-32000, "height parameter must be strictly greater than zero")
 	ErrParamIsNull          = common.NewErrorWCode(-32000, "parameter must not be null")
+
+	ErrMomentumHeightNotFound = common.NewErrorWCode(-32000, "momentum-height parameter is above the frontier momentum")
+	ErrMomentumHeightPruned   = common.NewErrorWCode(-32000, "the state at momentum-height was deleted by the pruning mode")
+
+	ErrIndexerDisabled    = common.NewErrorWCode(-32000, "the indexer is disabled, enable it with the --indexer flag")
+	ErrCursorParamInvalid = common.NewErrorWCode(-32000, "cursor parameter is invalid")
+	ErrHeightRangeInvalid = common.NewErrorWCode(-32000, "to-height parameter must not be lower than from-height parameter")
+	ErrTimeRangeTooBig    = common.NewErrorWCode(-32000, "time range is too big")
 )
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/inconshreveable/log15"
@@ -8,8 +9,10 @@ import (
 
 	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/indexer"
 	"github.com/zenon-network/go-zenon/vm"
 	"github.com/zenon-network/go-zenon/zenon"
 )
@@ -34,6 +37,8 @@ const (
 	unreceivedMaxPageIndex = 10
 	unreceivedMaxPageSize  = 50
 	unreceivedQuerySize    = unreceivedMaxPageIndex * unreceivedMaxPageSize
+
+	dailyBalancesMaxDays = 1000
 )
 
 func (l LedgerApi) String() string {
@@ -200,14 +205,72 @@ func (l *LedgerApi) GetAccountBlocksByPage(address types.Address, pageIndex, pag
 	}
 	return ans, nil
 }
+
+// SearchAccountBlocks returns the confirmed account-blocks matching filter in confirmation order, using the indexes of the node.
+// The search starts after cursor, the empty cursor starts from the first indexed momentum.
+func (l *LedgerApi) SearchAccountBlocks(filter *indexer.AccountBlockFilter, cursor string, count uint64) (*AccountBlockSearchResult, error) {
+	if l.z.Indexer() == nil {
+		return nil, ErrIndexerDisabled
+	}
+	if count > RpcMaxCountSize {
+		return nil, ErrCountParamTooBig
+	}
+	rawCursor, err := hex.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrCursorParamInvalid
+	}
+
+	indexedHeight := l.z.Indexer().Frontier().Height
+	hashes, next, err := l.z.Indexer().SearchAccountBlocks(filter, rawCursor, count)
+	if err == indexer.ErrInvalidCursor {
+		return nil, ErrCursorParamInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	momentumStore := l.chain.GetFrontierMomentumStore()
+	blocks := make([]*nom.AccountBlock, 0, len(hashes))
+	for _, hash := range hashes {
+		block, err := momentumStore.GetAccountBlockByHash(hash)
+		if err != nil {
+			l.log.Error("SearchAccountBlocks failed", "reason", err, "method-called", "momentumStore.GetAccountBlockByHash")
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	list, err := ledgerAccountBlocksToRpc(l.chain, blocks)
+	if err != nil {
+		l.log.Error("SearchAccountBlocks failed", "reason", err, "method-called", "ledgerAccountBlocksToRpc")
+		return nil, err
+	}
+
+	return &AccountBlockSearchResult{
+		List:          list,
+		Cursor:        hex.EncodeToString(next),
+		IndexedHeight: indexedHeight,
+	}, nil
+}
 func (l *LedgerApi) GetAccountInfoByAddress(address types.Address) (*AccountInfo, error) {
 	l.log.Info("GetAccountInfoByAddress")
 
-	momentumStore := l.chain.GetFrontierMomentumStore()
-	accountStore := l.chain.GetFrontierAccountStore(address)
+	return l.accountInfo(l.chain.GetFrontierMomentumStore(), l.chain.GetFrontierAccountStore(address), address, "GetAccountInfoByAddress")
+}
+
+// GetAccountInfoAt returns the balances of address confirmed by the momentum at momentumHeight
+func (l *LedgerApi) GetAccountInfoAt(address types.Address, momentumHeight uint64) (*AccountInfo, error) {
+	l.log.Info("GetAccountInfoAt")
+
+	_, context, err := GetContextAt(l.chain, address, momentumHeight)
+	if err != nil {
+		return nil, err
+	}
+	return l.accountInfo(context.MomentumStore(), context, address, "GetAccountInfoAt")
+}
+func (l *LedgerApi) accountInfo(momentumStore store.Momentum, accountStore store.Account, address types.Address, method string) (*AccountInfo, error) {
 	frontierAccountBlock, err := accountStore.Frontier()
 	if err != nil {
-		l.log.Error("GetFrontierAccountBlock failed, error is "+err.Error(), "method", "GetAccountInfoByAddress")
+		l.log.Error("GetFrontierAccountBlock failed, error is "+err.Error(), "method", method)
 		return nil, err
 	}
 
@@ -218,7 +281,7 @@ func (l *LedgerApi) GetAccountInfoByAddress(address types.Address) (*AccountInfo
 
 	balanceMap, err := accountStore.GetBalanceMap()
 	if err != nil {
-		l.log.Error("GetAccountBalance failed, error is "+err.Error(), "method", "GetAccountInfoByAddress")
+		l.log.Error("GetAccountBalance failed, error is "+err.Error(), "method", method)
 		return nil, err
 	}
 
@@ -242,6 +305,70 @@ func (l *LedgerApi) GetAccountInfoByAddress(address types.Address) (*AccountInfo
 		BalanceInfoMap: balanceInfoMap,
 	}, nil
 }
+
+// GetBalanceHistory returns the balance of address for zts after each momentum which changed it, between fromHeight and toHeight included.
+// At most RpcMaxCountSize changes are returned, the next ones are queried from the height of the last one plus one.
+func (l *LedgerApi) GetBalanceHistory(address types.Address, zts types.ZenonTokenStandard, fromHeight, toHeight uint64) (*BalanceHistory, error) {
+	if l.z.Indexer() == nil {
+		return nil, ErrIndexerDisabled
+	}
+	if fromHeight == 0 {
+		return nil, ErrHeightParamIsZero
+	}
+	if toHeight < fromHeight {
+		return nil, ErrHeightRangeInvalid
+	}
+
+	indexedHeight := l.z.Indexer().Frontier().Height
+	changes, more, err := l.z.Indexer().GetBalanceHistory(address, zts, fromHeight, toHeight, RpcMaxCountSize)
+	if err != nil {
+		l.log.Error("GetBalanceHistory failed", "reason", err, "method-called", "indexer.GetBalanceHistory")
+		return nil, err
+	}
+	list := make([]*BalanceChange, len(changes))
+	for i, change := range changes {
+		list[i] = &BalanceChange{
+			MomentumHeight: change.MomentumHeight,
+			Balance:        change.Balance,
+			Blocks:         change.Blocks,
+		}
+	}
+	return &BalanceHistory{
+		List:          list,
+		More:          more,
+		IndexedHeight: indexedHeight,
+	}, nil
+}
+
+// GetDailyBalances returns the balance of address for zts at the end of each UTC day between fromTimestamp and toTimestamp,
+// as confirmed by the last momentum of the day. The current day ends with the last indexed momentum.
+func (l *LedgerApi) GetDailyBalances(address types.Address, zts types.ZenonTokenStandard, fromTimestamp, toTimestamp int64) (*DailyBalanceList, error) {
+	if l.z.Indexer() == nil {
+		return nil, ErrIndexerDisabled
+	}
+	if toTimestamp-fromTimestamp > dailyBalancesMaxDays*24*60*60 {
+		return nil, ErrTimeRangeTooBig
+	}
+
+	indexedHeight := l.z.Indexer().Frontier().Height
+	balances, err := l.z.Indexer().GetDailyBalances(address, zts, fromTimestamp, toTimestamp)
+	if err != nil {
+		l.log.Error("GetDailyBalances failed", "reason", err, "method-called", "indexer.GetDailyBalances")
+		return nil, err
+	}
+	list := make([]*DailyBalance, len(balances))
+	for i, balance := range balances {
+		list[i] = &DailyBalance{
+			Day:            balance.Day,
+			MomentumHeight: balance.MomentumHeight,
+			Balance:        balance.Balance,
+		}
+	}
+	return &DailyBalanceList{
+		List:          list,
+		IndexedHeight: indexedHeight,
+	}, nil
+}
 func (l *LedgerApi) GetUnreceivedBlocksByAddress(address types.Address, pageIndex, pageSize uint32) (*AccountBlockList, error) {
 	l.log.Info("GetUnreceivedBlocksByAddress", "address", address, "page", pageIndex, "size", pageSize)
 	if pageSize > unreceivedMaxPageSize {
@@ -404,9 +531,10 @@ type LedgerMetaResponse struct {
 }
 
 func (l *LedgerApi) Meta() (*LedgerMetaResponse, error) {
+	params := l.chain.GetChainParams()
 	return &LedgerMetaResponse{
 		ChainId: l.chain.ChainIdentifier(),
-		ZToken:  types.ZnnTokenStandard,
-		QToken:  types.QsrTokenStandard,
+		ZToken:  params.ZnnTokenStandard,
+		QToken:  params.QsrTokenStandard,
 	}, nil
 }
@@ -265,6 +265,85 @@ func (abl *AccountBlockList) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type AccountBlockSearchResult struct {
+	List []*AccountBlock `json:"list"`
+	// Cursor continues the search, it's empty once the search reached IndexedHeight
+	Cursor        string `json:"cursor"`
+	IndexedHeight uint64 `json:"indexedHeight"`
+}
+
+type BalanceChange struct {
+	MomentumHeight uint64       `json:"momentumHeight"`
+	Balance        *big.Int     `json:"balance"`
+	Blocks         []types.Hash `json:"blocks"`
+}
+
+type BalanceChangeMarshal struct {
+	MomentumHeight uint64       `json:"momentumHeight"`
+	Balance        string       `json:"balance"`
+	Blocks         []types.Hash `json:"blocks"`
+}
+
+func (b *BalanceChange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&BalanceChangeMarshal{
+		MomentumHeight: b.MomentumHeight,
+		Balance:        b.Balance.String(),
+		Blocks:         b.Blocks,
+	})
+}
+func (b *BalanceChange) UnmarshalJSON(data []byte) error {
+	aux := new(BalanceChangeMarshal)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	b.MomentumHeight = aux.MomentumHeight
+	b.Balance = common.StringToBigInt(aux.Balance)
+	b.Blocks = aux.Blocks
+	return nil
+}
+
+type BalanceHistory struct {
+	List []*BalanceChange `json:"list"`
+	// More is set if there are more changes after the height of the last one
+	More          bool   `json:"more"`
+	IndexedHeight uint64 `json:"indexedHeight"`
+}
+
+type DailyBalance struct {
+	Day            int64    `json:"day"`
+	MomentumHeight uint64   `json:"momentumHeight"`
+	Balance        *big.Int `json:"balance"`
+}
+
+type DailyBalanceMarshal struct {
+	Day            int64  `json:"day"`
+	MomentumHeight uint64 `json:"momentumHeight"`
+	Balance        string `json:"balance"`
+}
+
+func (b *DailyBalance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&DailyBalanceMarshal{
+		Day:            b.Day,
+		MomentumHeight: b.MomentumHeight,
+		Balance:        b.Balance.String(),
+	})
+}
+func (b *DailyBalance) UnmarshalJSON(data []byte) error {
+	aux := new(DailyBalanceMarshal)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	b.Day = aux.Day
+	b.MomentumHeight = aux.MomentumHeight
+	b.Balance = common.StringToBigInt(aux.Balance)
+	return nil
+}
+
+type DailyBalanceList struct {
+	List          []*DailyBalance `json:"list"`
+	IndexedHeight uint64          `json:"indexedHeight"`
+}
+
 type MomentumList struct {
 	List  []*Momentum `json:"list"`
 	Count int         `json:"count"`
@@ -5,17 +5,21 @@ import (
 	"sync"
 
 	"github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
 
 	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus"
+	consensusapi "github.com/zenon-network/go-zenon/consensus/api"
 	rpc "github.com/zenon-network/go-zenon/rpc/server"
 )
 
 const (
 	acChanSize    = 100
 	mChanSize     = 100
+	msChanSize    = 100
 	installSize   = 100
 	uninstallSize = 100
 )
@@ -61,18 +65,20 @@ type Api struct {
 }
 type Server struct {
 	*Api
+	consensus consensus.Consensus
 
 	started       bool
 	uninstallCh   chan *Subscription // remove subscription
 	acCh          chan []*AccountBlock
 	mCh           chan *Momentum
+	msCh          chan *consensusapi.MissedSlot
 	stopped       chan struct{}
 	subscriptions map[SubscriptionType]map[rpc.ID]*Subscription
 
 	wg sync.WaitGroup
 }
 
-func GetSubscribeServer(chain chain.Chain) *Server {
+func GetSubscribeServer(chain chain.Chain, consensus consensus.Consensus) *Server {
 	oneSingleton.Lock()
 	defer oneSingleton.Unlock()
 
@@ -83,9 +89,11 @@ func GetSubscribeServer(chain chain.Chain) *Server {
 				log:       common.RPCLogger.New("module", "subscribe_api"),
 				installCh: make(chan *Subscription, installSize),
 			},
+			consensus: consensus,
 
 			acCh:          make(chan []*AccountBlock, acChanSize),
 			mCh:           make(chan *Momentum, mChanSize),
+			msCh:          make(chan *consensusapi.MissedSlot, msChanSize),
 			uninstallCh:   make(chan *Subscription, uninstallSize),
 			stopped:       make(chan struct{}),
 			subscriptions: make(map[SubscriptionType]map[rpc.ID]*Subscription),
@@ -118,6 +126,7 @@ func (s *Server) Start() error {
 	defer s.log.Info("finish start")
 	s.started = true
 	s.chain.Register(s)
+	s.consensus.RegisterMissedSlotListener(s)
 	s.wg.Add(1)
 	go func() {
 		s.work()
@@ -130,6 +139,7 @@ func (s *Server) Stop() error {
 	defer s.log.Info("finish stop")
 	s.started = false
 	s.chain.UnRegister(s)
+	s.consensus.UnRegisterMissedSlotListener(s)
 	close(s.stopped)
 	singleton = nil
 	s.log.Debug("wg.Wait() api Server.Stop()")
@@ -161,6 +171,13 @@ func (s *Server) InsertMomentum(detailed *nom.DetailedMomentum) {
 }
 func (s *Server) DeleteMomentum(*nom.DetailedMomentum) {
 }
+func (s *Server) NewMissedSlot(event consensusapi.MissedSlot) {
+	select {
+	case s.msCh <- &event:
+	default:
+		s.log.Error("can't insert missed slot for broadcast", "reason", "channel is full", "name", event.Slot.Name)
+	}
+}
 
 func (s *Server) work() {
 	log := s.log.New("module", "worker")
@@ -181,6 +198,8 @@ func (s *Server) work() {
 			s.broadcastMomentums(momentums)
 		case blocks := <-s.acCh:
 			s.broadcastBlocks(blocks)
+		case missed := <-s.msCh:
+			s.broadcastMissedSlot(missed)
 		}
 	}
 }
@@ -258,6 +277,19 @@ func (s *Server) broadcastBlocks(blocks []*AccountBlock) {
 
 	s.log.Info("finish broadcasting account-blocks", "elapsed", common.Clock.Now().Sub(startTime), "stats", stats)
 }
+func (s *Server) broadcastMissedSlot(missed *consensusapi.MissedSlot) {
+	startTime := common.Clock.Now()
+	stats := &BroadcastStats{}
+
+	// notify once per outage, when the pillar reaches the threshold of the subscription
+	for _, f := range s.subscriptions[MissedSlotsSubscription] {
+		if f.options.threshold == missed.ConsecutiveMissed {
+			s.broadcast(f, []interface{}{missed}, stats)
+		}
+	}
+
+	s.log.Info("finish broadcasting missed slot", "name", missed.Slot.Name, "elapsed", common.Clock.Now().Sub(startTime), "stats", stats)
+}
 
 func (s *Api) subscribe(ctx context.Context, options *subscriptionOptions) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
@@ -285,3 +317,23 @@ func (s *Api) UnreceivedAccountBlocksByAddress(ctx context.Context, address type
 	s.log.Info("new subscription", "type", "UnreceivedAccountBlocksByAddress")
 	return s.subscribe(ctx, NewToUnreceivedBlocksSubscription(address))
 }
+
+// ConsensusApi exposes the subscriptions to consensus events in the consensus namespace
+type ConsensusApi struct {
+	api *Api
+}
+
+func GetConsensusSubscribeApi() *ConsensusApi {
+	return &ConsensusApi{
+		api: GetSubscribeApi(),
+	}
+}
+
+// MissedSlots notifies when a pillar misses threshold consecutive slots
+func (s *ConsensusApi) MissedSlots(ctx context.Context, threshold uint64) (*rpc.Subscription, error) {
+	s.api.log.Info("new subscription", "type", "MissedSlots")
+	if threshold == 0 {
+		return nil, errors.Errorf("threshold must be greater than 0")
+	}
+	return s.api.subscribe(ctx, NewMissedSlotsSubscription(threshold))
+}
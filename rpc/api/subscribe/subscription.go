@@ -18,6 +18,7 @@ const (
 	AccountBlocksSubscriptionByAddress
 	UnreceivedAccountBlocksSubscriptionByAddress
 	MomentumsSubscription
+	MissedSlotsSubscription
 	LastSubscriptionType
 )
 
@@ -25,6 +26,7 @@ type subscriptionOptions struct {
 	subscriptionType SubscriptionType
 	createTime       time.Time
 	address          types.Address
+	threshold        uint64
 }
 
 func newSubscription(subscriptionType SubscriptionType) *subscriptionOptions {
@@ -49,6 +51,11 @@ func NewToUnreceivedBlocksSubscription(addr types.Address) *subscriptionOptions
 func NewMomentumsSubscription() *subscriptionOptions {
 	return newSubscription(MomentumsSubscription)
 }
+func NewMissedSlotsSubscription(threshold uint64) *subscriptionOptions {
+	sub := newSubscription(MissedSlotsSubscription)
+	sub.threshold = threshold
+	return sub
+}
 
 type Subscription struct {
 	log      log15.Logger
@@ -5,6 +5,7 @@ import (
 
 	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/vm/vm_context"
 )
@@ -42,6 +43,37 @@ func GetFrontierContext(c chain.Chain, addr types.Address) (*nom.Momentum, vm_co
 	return frontier, context, nil
 }
 
+// GetContextAt returns the momentum at momentumHeight and a context which reads the state confirmed by it.
+// The context doesn't include the unconfirmed account-blocks of addr.
+func GetContextAt(c chain.Chain, addr types.Address, momentumHeight uint64) (*nom.Momentum, vm_context.AccountVmContext, error) {
+	if momentumHeight == 0 {
+		return nil, nil, ErrHeightParamIsZero
+	}
+	momentum, err := c.GetFrontierMomentumStore().GetMomentumByHeight(momentumHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	if momentum == nil {
+		return nil, nil, ErrMomentumHeightNotFound
+	}
+	store, err := c.GetMomentumStore(momentum.Identifier())
+	if err == db.ErrPruned {
+		return nil, nil, ErrMomentumHeightPruned
+	} else if err != nil {
+		return nil, nil, err
+	}
+	if store == nil {
+		return nil, nil, ErrMomentumHeightNotFound
+	}
+
+	context := vm_context.NewAccountContext(
+		store,
+		store.GetAccountStore(addr),
+		nil,
+	)
+	return momentum, context, nil
+}
+
 func checkTokenIdValid(chain chain.Chain, ts *types.ZenonTokenStandard) error {
 	store := chain.GetFrontierMomentumStore()
 	if ts != nil && (*ts) != types.ZeroTokenStandard {
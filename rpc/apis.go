@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/p2p"
 	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/rpc/api/embedded"
@@ -30,7 +31,7 @@ func getApi(z zenon.Zenon, p2p *p2p.Server, apiModule string) []rpc.API {
 			},
 		}
 	case "embedded":
-		return []rpc.API{
+		return enabledEmbeddedApis(z, []rpc.API{
 			{
 				Namespace: "embedded.token",
 				Version:   "1.0",
@@ -97,6 +98,36 @@ func getApi(z zenon.Zenon, p2p *p2p.Server, apiModule string) []rpc.API {
 				Service:   embedded.NewLiquidityApi(z),
 				Public:    true,
 			},
+			{
+				Namespace: "embedded.parameters",
+				Version:   "1.0",
+				Service:   embedded.NewParametersApi(z),
+				Public:    true,
+			},
+			{
+				Namespace: "embedded.authority",
+				Version:   "1.0",
+				Service:   embedded.NewAuthorityApi(z),
+				Public:    true,
+			},
+		})
+	case "consensus":
+		return []rpc.API{
+			{
+				Namespace: "consensus",
+				Version:   "1.0",
+				Service:   api.NewConsensusApi(z),
+				Public:    true,
+			},
+		}
+	case "consensusSubscribe":
+		return []rpc.API{
+			{
+				Namespace: "consensus",
+				Version:   "1.0",
+				Service:   subscribe.GetConsensusSubscribeApi(),
+				Public:    true,
+			},
 		}
 	case "stats":
 		return []rpc.API{
@@ -111,6 +142,36 @@ func getApi(z zenon.Zenon, p2p *p2p.Server, apiModule string) []rpc.API {
 		return []rpc.API{}
 	}
 }
+
+// embeddedNamespaces maps the embedded namespaces to the contract they expose
+var embeddedNamespaces = map[string]types.Address{
+	"embedded.token":       types.TokenContract,
+	"embedded.sentinel":    types.SentinelContract,
+	"embedded.pillar":      types.PillarContract,
+	"embedded.plasma":      types.PlasmaContract,
+	"embedded.stake":       types.StakeContract,
+	"embedded.swap":        types.SwapContract,
+	"embedded.spork":       types.SporkContract,
+	"embedded.accelerator": types.AcceleratorContract,
+	"embedded.htlc":        types.HtlcContract,
+	"embedded.bridge":      types.BridgeContract,
+	"embedded.liquidity":   types.LiquidityContract,
+	"embedded.authority":   types.AuthorityContract,
+}
+
+// enabledEmbeddedApis hides the namespaces of the embedded contracts which are disabled by the genesis
+func enabledEmbeddedApis(z zenon.Zenon, apis []rpc.API) []rpc.API {
+	params := z.Chain().GetChainParams()
+	enabled := make([]rpc.API, 0, len(apis))
+	for _, api := range apis {
+		if address, ok := embeddedNamespaces[api.Namespace]; ok && !params.IsContractEnabled(address) {
+			continue
+		}
+		enabled = append(enabled, api)
+	}
+	return enabled
+}
+
 func GetApis(z zenon.Zenon, p2p *p2p.Server, apiModule ...string) []rpc.API {
 	var apis []rpc.API
 	for _, m := range apiModule {
@@ -119,5 +180,5 @@ func GetApis(z zenon.Zenon, p2p *p2p.Server, apiModule ...string) []rpc.API {
 	return apis
 }
 func GetPublicApis(z zenon.Zenon, p2p *p2p.Server) []rpc.API {
-	return GetApis(z, p2p, "ledger", "ledgerSubscribe", "embedded", "stats")
+	return GetApis(z, p2p, "ledger", "ledgerSubscribe", "embedded", "consensus", "consensusSubscribe", "stats")
 }
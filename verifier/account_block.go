@@ -45,12 +45,18 @@ func (av *accountVerifier) getContext(block *nom.AccountBlock) (store.Account, s
 	if block.MomentumAcknowledged.IsZero() {
 		return nil, nil, ErrABMAMustNotBeZero
 	}
-	momentumStore := av.chain.GetMomentumStore(block.MomentumAcknowledged)
+	momentumStore, err := av.chain.GetMomentumStore(block.MomentumAcknowledged)
+	if err != nil {
+		return nil, nil, InternalError(err)
+	}
 	if momentumStore == nil {
 		return nil, nil, ErrABMAMissing
 	}
 
-	accountStore := av.chain.GetAccountStore(block.Address, block.Previous())
+	accountStore, err := av.chain.GetAccountStore(block.Address, block.Previous())
+	if err != nil {
+		return nil, nil, InternalError(err)
+	}
 
 	if accountStore == nil {
 		// try to give a better error in case we are not able to give a better error
@@ -0,0 +1,154 @@
+package verifier
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+)
+
+// Corruption is the first momentum whose stored data is inconsistent
+type Corruption struct {
+	Height uint64 `json:"height"`
+	Reason string `json:"reason"`
+}
+
+// StoredMomentum checks the hash, signature and changes-hash of a momentum read from the local DB.
+// The producer isn't checked, since it requires the consensus.
+func StoredMomentum(transaction *nom.MomentumTransaction) error {
+	mv := &momentumTransactionVerifier{
+		transaction: transaction,
+	}
+	if err := mv.changesHash(transaction); err != nil {
+		return err
+	}
+	if err := mv.hash(transaction); err != nil {
+		return err
+	}
+	// the genesis momentum isn't signed
+	if transaction.Momentum.Height == 1 {
+		return nil
+	}
+	return mv.signature(transaction)
+}
+
+// StoredAccountBlock checks the hash and signature of an account-block read from the local DB
+func StoredAccountBlock(block *nom.AccountBlock) error {
+	abvt := &accountBlockTransactionVerifier{
+		transaction: &nom.AccountBlockTransaction{
+			Block: block,
+		},
+	}
+	if err := abvt.hash(); err != nil {
+		return err
+	}
+	if err := abvt.signature(); err != nil {
+		return err
+	}
+	return abvt.producer()
+}
+
+// VerifyIntegrity walks the stored momentums from fromHeight up to the frontier and returns the first one which is corrupted.
+//
+// For each momentum it checks:
+//   - the link with the previous momentum and the hash and height indexes
+//   - the changes-hash recomputed from the stored patch, the hash and the signature
+//   - the account-blocks of the content are stored, valid and confirmed by the momentum
+//
+// Returns nil if all the momentums are consistent. The heights deleted by the pruning mode can't be verified.
+func VerifyIntegrity(ch chain.Chain, fromHeight uint64, progress func(height, frontierHeight uint64)) (*Corruption, error) {
+	log := common.VerifierLogger.New("submodule", "integrity")
+	momentumStore := ch.GetFrontierMomentumStore()
+	frontierHeight := momentumStore.Identifier().Height
+	if fromHeight == 0 {
+		fromHeight = 1
+	}
+
+	corrupted := func(height uint64, format string, args ...interface{}) (*Corruption, error) {
+		corruption := &Corruption{
+			Height: height,
+			Reason: fmt.Sprintf(format, args...),
+		}
+		log.Error("found corrupted momentum", "height", corruption.Height, "reason", corruption.Reason)
+		return corruption, nil
+	}
+
+	var previous *nom.Momentum
+	if fromHeight > 1 {
+		var err error
+		if previous, err = momentumStore.GetMomentumByHeight(fromHeight - 1); err != nil {
+			return nil, err
+		}
+		if previous == nil {
+			return corrupted(fromHeight-1, "missing momentum")
+		}
+	}
+
+	lastLog := time.Now()
+	for height := fromHeight; height <= frontierHeight; height += 1 {
+		momentum, err := momentumStore.GetMomentumByHeight(height)
+		if err != nil {
+			return corrupted(height, "unreadable momentum: %v", err)
+		}
+		if momentum == nil {
+			return corrupted(height, "missing momentum")
+		}
+		if momentum.Height != height {
+			return corrupted(height, "momentum has height %v", momentum.Height)
+		}
+		if height == 1 && momentum.Hash != ch.GetGenesisMomentum().Hash {
+			return corrupted(height, "genesis momentum %v differs from the configured genesis %v", momentum.Hash, ch.GetGenesisMomentum().Hash)
+		}
+		if previous != nil && momentum.Previous() != previous.Identifier() {
+			return corrupted(height, "previous momentum is %v but the stored one is %v", momentum.Previous(), previous.Identifier())
+		}
+		if byHash, err := momentumStore.GetMomentumByHash(momentum.Hash); err != nil || byHash == nil || byHash.Height != height {
+			return corrupted(height, "hash index doesn't point to the momentum")
+		}
+
+		changes, err := ch.GetMomentumChanges(momentum.Identifier())
+		if err != nil {
+			return nil, err
+		}
+		if changes == nil {
+			return corrupted(height, "missing patch")
+		}
+		if err := StoredMomentum(&nom.MomentumTransaction{
+			Momentum: momentum,
+			Changes:  changes,
+		}); err != nil {
+			return corrupted(height, "%v", err)
+		}
+
+		for _, header := range momentum.Content {
+			block, err := momentumStore.GetAccountBlock(*header)
+			if err != nil {
+				return corrupted(height, "unreadable account-block %v: %v", header.Hash, err)
+			}
+			if block == nil || block.Hash != header.Hash {
+				return corrupted(height, "missing account-block %v", header.Hash)
+			}
+			if height != 1 {
+				if err := StoredAccountBlock(block); err != nil {
+					return corrupted(height, "account-block %v: %v", header.Hash, err)
+				}
+			}
+			confirmationHeight, err := momentumStore.GetBlockConfirmationHeight(block.Hash)
+			if err != nil {
+				return corrupted(height, "unreadable confirmation height of account-block %v: %v", header.Hash, err)
+			}
+			if confirmationHeight != height {
+				return corrupted(height, "account-block %v is confirmed at height %v", header.Hash, confirmationHeight)
+			}
+		}
+
+		previous = momentum
+		if progress != nil && time.Since(lastLog) > 10*time.Second {
+			progress(height, frontierHeight)
+			lastLog = time.Now()
+		}
+	}
+	return nil, nil
+}
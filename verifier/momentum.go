@@ -36,7 +36,10 @@ func (mv *momentumVerifier) getContext(momentum *nom.Momentum) (store.Momentum,
 		return nil, ErrMPrevHashMissing
 	}
 
-	momentumStore := mv.chain.GetMomentumStore(momentum.Previous())
+	momentumStore, err := mv.chain.GetMomentumStore(momentum.Previous())
+	if err != nil {
+		return nil, InternalError(err)
+	}
 	if momentumStore == nil {
 		return nil, ErrMPreviousMissing
 	}
@@ -0,0 +1,97 @@
+package verifier
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/momentum"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// VerifySnapshot checks state, the state of a momentum served by a peer, before it's installed.
+// The chain doesn't commit to whole states, so the state itself is trusted through the digest of a trusted checkpoint,
+// see protocol.DownloadSnapshot. VerifySnapshot checks that it's the state of the checkpoint momentum anchor:
+//   - the frontier momentum of the state is anchor
+//   - the stored momentums link anchor to the genesis momentum, with valid hashes and signatures
+//   - the account-blocks of their content are stored, valid and confirmed by them
+//
+// The producers aren't checked, since the elections of the old momentums need the states which precede the snapshot,
+// they are trusted through the hash chain of anchor.
+func VerifySnapshot(genesis store.Genesis, state db.DB, anchor types.HashHeight) error {
+	log := common.VerifierLogger.New("submodule", "snapshot")
+	momentumStore := momentum.NewStore(genesis, state)
+	frontier, err := momentumStore.GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+	if frontier == nil {
+		return errors.Errorf("snapshot state has no frontier momentum")
+	}
+	if frontier.Identifier() != anchor {
+		return errors.Errorf("snapshot frontier momentum %v differs from the checkpoint %v", frontier.Identifier(), anchor)
+	}
+
+	mv := &momentumTransactionVerifier{}
+	next := frontier
+	lastLog := time.Now()
+	for height := frontier.Height; height > 0; height -= 1 {
+		current, err := momentumStore.GetMomentumByHeight(height)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.Height != height {
+			return errors.Errorf("snapshot momentum at height %v is missing", height)
+		}
+		if height != frontier.Height && next.Previous() != current.Identifier() {
+			return errors.Errorf("snapshot momentum %v doesn't link to %v", next.Identifier(), current.Identifier())
+		}
+		if byHash, err := momentumStore.GetMomentumByHash(current.Hash); err != nil || byHash == nil || byHash.Height != height {
+			return errors.Errorf("snapshot hash index doesn't point to momentum %v", current.Identifier())
+		}
+		transaction := &nom.MomentumTransaction{Momentum: current}
+		if err := mv.hash(transaction); err != nil {
+			return errors.Wrapf(err, "snapshot momentum %v", current.Identifier())
+		}
+		if height == 1 {
+			if current.Hash != genesis.GetGenesisMomentum().Hash {
+				return errors.Errorf("snapshot genesis momentum %v differs from the configured genesis %v", current.Hash, genesis.GetGenesisMomentum().Hash)
+			}
+		} else if err := mv.signature(transaction); err != nil {
+			return errors.Wrapf(err, "snapshot momentum %v", current.Identifier())
+		}
+
+		for _, header := range current.Content {
+			block, err := momentumStore.GetAccountBlock(*header)
+			if err != nil {
+				return err
+			}
+			if block == nil || block.Hash != header.Hash {
+				return errors.Errorf("snapshot account-block %v is missing", header.Hash)
+			}
+			if height != 1 {
+				if err := StoredAccountBlock(block); err != nil {
+					return errors.Wrapf(err, "snapshot account-block %v", header.Hash)
+				}
+			}
+			confirmationHeight, err := momentumStore.GetBlockConfirmationHeight(block.Hash)
+			if err != nil {
+				return err
+			}
+			if confirmationHeight != height {
+				return errors.Errorf("snapshot account-block %v is confirmed at height %v instead of %v", header.Hash, confirmationHeight, height)
+			}
+		}
+
+		next = current
+		if time.Since(lastLog) > 10*time.Second {
+			log.Info("verifying snapshot", "height", height, "frontier-identifier", frontier.Identifier())
+			lastLog = time.Now()
+		}
+	}
+	return nil
+}
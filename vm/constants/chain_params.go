@@ -0,0 +1,210 @@
+package constants
+
+import (
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// ChainParams groups the parameters of a chain which can be changed by its genesis.
+// Every component of a chain (vm, consensus, rpc) reads them from the chain's genesis instead of the package vars,
+// so several chains with different parameters can run in the same process.
+// The package vars only hold the mainnet values used by DefaultChainParams.
+type ChainParams struct {
+	ZnnTokenStandard types.ZenonTokenStandard
+	QsrTokenStandard types.ZenonTokenStandard
+	// ZnnDecimals and QsrDecimals are the decimals of the tokens used as ZNN and QSR,
+	// all the ZNN and QSR amounts below are expressed in base units of these decimals
+	ZnnDecimals uint8
+	QsrDecimals uint8
+	// SporkAddress is the only address allowed to create and activate sporks
+	SporkAddress *types.Address
+	// EnabledContracts is the set of embedded contracts available on this chain, nil enables all of them
+	EnabledContracts map[types.Address]bool
+	// ActiveSporks is the set of implemented sporks which are active since the genesis momentum
+	ActiveSporks map[types.Hash]bool
+
+	Consensus *Consensus
+
+	MomentumsPerHour  int64
+	MomentumsPerEpoch int64
+	// UpdateMinNumMomentums is the number momentums between 2 UpdateEmbedded* calls which will execute, used for all applicable contracts
+	UpdateMinNumMomentums uint64
+
+	/// === Accelerator ===
+
+	ProjectZnnMaximumFunds         *big.Int
+	ProjectQsrMaximumFunds         *big.Int
+	ProjectCreationAmount          *big.Int
+	VoteAcceptanceThreshold        uint32
+	AcceleratorProjectVotingPeriod int64
+
+	/// === Pillar ===
+
+	PillarStakeAmount            *big.Int
+	PillarQsrStakeBaseAmount     *big.Int
+	PillarQsrStakeIncreaseAmount *big.Int
+	PillarEpochLockTime          int64
+	PillarEpochRevokeTime        int64
+
+	/// === Sentinel ===
+
+	SentinelZnnRegisterAmount *big.Int
+	SentinelQsrDepositAmount  *big.Int
+	SentinelLockTimeWindow    int64
+	SentinelRevokeTimeWindow  int64
+
+	/// === Staking ===
+
+	StakeTimeUnitSec int64
+	StakeTimeMinSec  int64
+	StakeTimeMaxSec  int64
+	StakeMinAmount   *big.Int
+
+	/// === Plasma ===
+
+	FuseMinAmount     *big.Int
+	FuseExpiration    uint64
+	CostPerFusionUnit *big.Int
+
+	/// === Token ===
+
+	TokenIssueAmount *big.Int
+
+	/// === Bridge & Liquidity ===
+
+	MinUnhaltDurationInMomentums uint64
+	MinAdministratorDelay        uint64 // default delay of the administrator time challenges
+	MinSoftDelay                 uint64 // default delay of the soft time challenges
+
+	/// === Reward ===
+
+	// RewardTickDurationInEpochs is the number of epochs of each entry of the reward configs,
+	// the last entry is emitted forever
+	RewardTickDurationInEpochs uint64
+	NetworkZnnRewardConfig     []*big.Int
+	NetworkQsrRewardConfig     []*big.Int
+
+	DelegationZnnRewardPercentage        int64
+	MomentumProducingZnnRewardPercentage int64
+	SentinelZnnRewardPercentage          int64
+	LiquidityZnnRewardPercentage         int64
+	StakingQsrRewardPercentage           int64
+	SentinelQsrRewardPercentage          int64
+	LiquidityQsrRewardPercentage         int64
+}
+
+// DefaultChainParams returns a copy of the mainnet parameters
+func DefaultChainParams() *ChainParams {
+	consensus := *ConsensusConfig
+	return &ChainParams{
+		ZnnTokenStandard: types.ZnnTokenStandard,
+		QsrTokenStandard: types.QsrTokenStandard,
+		ZnnDecimals:      NumDecimals,
+		QsrDecimals:      NumDecimals,
+
+		Consensus: &consensus,
+
+		MomentumsPerHour:      MomentumsPerHour,
+		MomentumsPerEpoch:     MomentumsPerEpoch,
+		UpdateMinNumMomentums: UpdateMinNumMomentums,
+
+		ProjectZnnMaximumFunds:         new(big.Int).Set(ProjectZnnMaximumFunds),
+		ProjectQsrMaximumFunds:         new(big.Int).Set(ProjectQsrMaximumFunds),
+		ProjectCreationAmount:          new(big.Int).Set(ProjectCreationAmount),
+		VoteAcceptanceThreshold:        VoteAcceptanceThreshold,
+		AcceleratorProjectVotingPeriod: AcceleratorProjectVotingPeriod,
+
+		PillarStakeAmount:            new(big.Int).Set(PillarStakeAmount),
+		PillarQsrStakeBaseAmount:     new(big.Int).Set(PillarQsrStakeBaseAmount),
+		PillarQsrStakeIncreaseAmount: new(big.Int).Set(PillarQsrStakeIncreaseAmount),
+		PillarEpochLockTime:          PillarEpochLockTime,
+		PillarEpochRevokeTime:        PillarEpochRevokeTime,
+
+		SentinelZnnRegisterAmount: new(big.Int).Set(SentinelZnnRegisterAmount),
+		SentinelQsrDepositAmount:  new(big.Int).Set(SentinelQsrDepositAmount),
+		SentinelLockTimeWindow:    SentinelLockTimeWindow,
+		SentinelRevokeTimeWindow:  SentinelRevokeTimeWindow,
+
+		StakeTimeUnitSec: StakeTimeUnitSec,
+		StakeTimeMinSec:  StakeTimeMinSec,
+		StakeTimeMaxSec:  StakeTimeMaxSec,
+		StakeMinAmount:   new(big.Int).Set(StakeMinAmount),
+
+		FuseMinAmount:     new(big.Int).Set(FuseMinAmount),
+		FuseExpiration:    FuseExpiration,
+		CostPerFusionUnit: big.NewInt(CostPerFusionUnit),
+
+		TokenIssueAmount: new(big.Int).Set(TokenIssueAmount),
+
+		MinUnhaltDurationInMomentums: MinUnhaltDurationInMomentums,
+		MinAdministratorDelay:        MinAdministratorDelay,
+		MinSoftDelay:                 MinSoftDelay,
+
+		RewardTickDurationInEpochs: RewardTickDurationInEpochs,
+		NetworkZnnRewardConfig:     bigRewardConfig(NetworkZnnRewardConfig),
+		NetworkQsrRewardConfig:     bigRewardConfig(NetworkQsrRewardConfig),
+
+		DelegationZnnRewardPercentage:        DelegationZnnRewardPercentage,
+		MomentumProducingZnnRewardPercentage: MomentumProducingZnnRewardPercentage,
+		SentinelZnnRewardPercentage:          SentinelZnnRewardPercentage,
+		LiquidityZnnRewardPercentage:         LiquidityZnnRewardPercentage,
+		StakingQsrRewardPercentage:           StakingQsrRewardPercentage,
+		SentinelQsrRewardPercentage:          SentinelQsrRewardPercentage,
+		LiquidityQsrRewardPercentage:         LiquidityQsrRewardPercentage,
+	}
+}
+
+// IsContractEnabled returns whether send blocks to the embedded contract are accepted
+func (p *ChainParams) IsContractEnabled(address types.Address) bool {
+	// the authority contract exists only on proof of authority chains
+	if address == types.AuthorityContract && p.Consensus.Mode != ProofOfAuthority {
+		return false
+	}
+	return p.EnabledContracts == nil || p.EnabledContracts[address]
+}
+
+func bigRewardConfig(config []int64) []*big.Int {
+	result := make([]*big.Int, len(config))
+	for i, reward := range config {
+		result[i] = big.NewInt(reward)
+	}
+	return result
+}
+
+// SetTokenDecimals rescales the ZNN and QSR amounts to the given decimals.
+// The mainnet amounts are multiples of whole tokens, so scaling them down to fewer decimals is exact.
+func (p *ChainParams) SetTokenDecimals(znnDecimals, qsrDecimals uint8) {
+	znnAmounts := []*big.Int{
+		p.ProjectZnnMaximumFunds,
+		p.ProjectCreationAmount,
+		p.PillarStakeAmount,
+		p.SentinelZnnRegisterAmount,
+		p.StakeMinAmount,
+		p.TokenIssueAmount,
+	}
+	qsrAmounts := []*big.Int{
+		p.ProjectQsrMaximumFunds,
+		p.PillarQsrStakeBaseAmount,
+		p.PillarQsrStakeIncreaseAmount,
+		p.SentinelQsrDepositAmount,
+		p.FuseMinAmount,
+		p.CostPerFusionUnit,
+	}
+	for _, amount := range append(znnAmounts, p.NetworkZnnRewardConfig...) {
+		scaleDecimals(amount, p.ZnnDecimals, znnDecimals)
+	}
+	for _, amount := range append(qsrAmounts, p.NetworkQsrRewardConfig...) {
+		scaleDecimals(amount, p.QsrDecimals, qsrDecimals)
+	}
+	p.ZnnDecimals = znnDecimals
+	p.QsrDecimals = qsrDecimals
+}
+
+func scaleDecimals(amount *big.Int, from, to uint8) {
+	if to > from {
+		amount.Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil))
+	} else if to < from {
+		amount.Quo(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil))
+	}
+}
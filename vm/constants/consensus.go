@@ -1,22 +1,60 @@
 package constants
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/zenon-network/go-zenon/common/types"
 )
 
-type ElectionAlgorithm uint8
+// ElectionAlgorithm is the name of an election algorithm, as used in genesis files.
+// The algorithms are implemented and registered by the consensus package, see consensus.ParseElectionAlgorithm.
+type ElectionAlgorithm string
+
+const (
+	MAINNET ElectionAlgorithm = "MAINNET"
+	UNIFORM ElectionAlgorithm = "UNIFORM"
+	// UNIFORM_HASH works like UNIFORM but is seeded with the hash of the proof momentum,
+	// so the producers of a tick are known only after the previous tick
+	UNIFORM_HASH ElectionAlgorithm = "UNIFORM_HASH"
+)
+
+func (algorithm ElectionAlgorithm) String() string {
+	return string(algorithm)
+}
+
+// ConsensusMode selects where the producers of an election come from
+type ConsensusMode string
 
 const (
-	MAINNET ElectionAlgorithm = iota
-	UNIFORM
+	// ProofOfStake elects the producers among the pillars of the PillarContract, weighted by their delegations
+	ProofOfStake ConsensusMode = "POS"
+	// ProofOfAuthority elects the producers among the authorities of the AuthorityContract, all with the same weight
+	ProofOfAuthority ConsensusMode = "POA"
 )
 
+func (mode ConsensusMode) String() string {
+	return string(mode)
+}
+
+// ParseConsensusMode returns the ConsensusMode with the given name, as used in genesis files.
+// An empty name selects ProofOfStake.
+func ParseConsensusMode(name string) (ConsensusMode, error) {
+	switch ConsensusMode(name) {
+	case "", ProofOfStake:
+		return ProofOfStake, nil
+	case ProofOfAuthority:
+		return ProofOfAuthority, nil
+	}
+	return ProofOfStake, errors.Errorf("unknown consensus mode %q", name)
+}
+
 type Consensus struct {
 	BlockTime   int64                    // Interval in seconds between 2 momentums
 	NodeCount   uint8                    // NodeCount in an election tick
 	RandCount   uint8                    // RandCount of pillars which are chosen in an election tick
 	CountingZTS types.ZenonTokenStandard // CountingZTS used to compute pillar weights
 	Algorithm   ElectionAlgorithm        // Algorithm determines the election algorithm used
+	Mode        ConsensusMode            // Mode determines whether pillars or authorities produce momentums
 }
 
 var (
@@ -26,5 +64,6 @@ var (
 		RandCount:   15,
 		CountingZTS: types.ZnnTokenStandard,
 		Algorithm:   MAINNET,
+		Mode:        ProofOfStake,
 	}
 )
@@ -3,6 +3,7 @@ package constants
 import (
 	"github.com/zenon-network/go-zenon/common/types"
 	"math/big"
+	"regexp"
 
 	"github.com/zenon-network/go-zenon/common"
 )
@@ -10,6 +11,8 @@ import (
 const (
 	Decimals  = 100000000
 	SecsInDay = 24 * 60 * 60
+	// NumDecimals is the number of decimals of ZNN and QSR on mainnet, Decimals = 10^NumDecimals
+	NumDecimals = 8
 )
 
 var (
@@ -45,6 +48,8 @@ var (
 	PillarEpochLockTime          int64 = 83 * SecsInDay
 	PillarEpochRevokeTime        int64 = 7 * SecsInDay
 	PillarNameLengthMax                = 40
+	// PillarNameRegExp matches the valid names of the pillars and of the authorities
+	PillarNameRegExp = regexp.MustCompile("^([a-zA-Z0-9]+[-._]?)*[a-zA-Z0-9]$")
 
 	/// === Sentinel constants ===
 
@@ -82,6 +87,13 @@ var (
 	SporkNameMaxLength        = 40
 	SporkDescriptionMaxLength = 400
 
+	/// === Authority constants ===
+
+	AuthorityAdminsMaxNum = 16 // Maximum number of administrators of the authority contract
+	AuthorityMaxNum       = 64 // Maximum number of authorities
+	// AuthorityProposalExpirationEpochs is the number of epochs after which the votes of a proposal are dropped
+	AuthorityProposalExpirationEpochs = uint64(7)
+
 	/// === Swap constants ===
 
 	// SwapAssetDecayEpochsOffset is the number of epochs before the decay kicks in
@@ -149,47 +161,81 @@ var (
 	}
 )
 
-func NetworkZnnRewardPerEpoch(epoch uint64) int64 {
-	tick := int(epoch / RewardTickDurationInEpochs)
-	if tick >= len(NetworkZnnRewardConfig) {
-		return NetworkZnnRewardConfig[len(NetworkZnnRewardConfig)-1]
+func (p *ChainParams) NetworkZnnRewardPerEpoch(epoch uint64) *big.Int {
+	tick := int(epoch / p.RewardTickDurationInEpochs)
+	if tick >= len(p.NetworkZnnRewardConfig) {
+		return p.NetworkZnnRewardConfig[len(p.NetworkZnnRewardConfig)-1]
 	} else {
-		return NetworkZnnRewardConfig[tick]
+		return p.NetworkZnnRewardConfig[tick]
 	}
 }
 
-func NetworkQsrRewardPerEpoch(epoch uint64) int64 {
-	tick := int(epoch / RewardTickDurationInEpochs)
-	if tick >= len(NetworkQsrRewardConfig) {
-		return NetworkQsrRewardConfig[len(NetworkQsrRewardConfig)-1]
+func (p *ChainParams) NetworkQsrRewardPerEpoch(epoch uint64) *big.Int {
+	tick := int(epoch / p.RewardTickDurationInEpochs)
+	if tick >= len(p.NetworkQsrRewardConfig) {
+		return p.NetworkQsrRewardConfig[len(p.NetworkQsrRewardConfig)-1]
 	} else {
-		return NetworkQsrRewardConfig[tick]
+		return p.NetworkQsrRewardConfig[tick]
 	}
 }
 
-// PillarRewardPerMomentum returns delegation & producing reward per momentum.
-func PillarRewardPerMomentum(epoch uint64) (*big.Int, *big.Int) {
-	delegation := (NetworkZnnRewardPerEpoch(epoch) * DelegationZnnRewardPercentage) / 100 / MomentumsPerEpoch
-	producing := (NetworkZnnRewardPerEpoch(epoch) * MomentumProducingZnnRewardPercentage) / 100 / MomentumsPerEpoch
-	return big.NewInt(delegation), big.NewInt(producing)
+// rewardPercentage returns percentage% of amount, rounded down
+func rewardPercentage(amount *big.Int, percentage int64) *big.Int {
+	result := new(big.Int).Mul(amount, big.NewInt(percentage))
+	return result.Quo(result, big.NewInt(100))
+}
+
+// PillarRewardPerMomentum returns delegation & producing reward per momentum of an epoch with the given block time.
+// The reward of an epoch doesn't change with the block time, a shorter block time splits it among more momentums.
+func (p *ChainParams) PillarRewardPerMomentum(epoch uint64, blockTime int64) (*big.Int, *big.Int) {
+	momentums := big.NewInt(p.MomentumsPerEpoch)
+	if blockTime > 0 && blockTime != p.Consensus.BlockTime {
+		momentums.Mul(momentums, big.NewInt(p.Consensus.BlockTime))
+		momentums.Quo(momentums, big.NewInt(blockTime))
+	}
+	delegation := rewardPercentage(p.NetworkZnnRewardPerEpoch(epoch), p.DelegationZnnRewardPercentage)
+	producing := rewardPercentage(p.NetworkZnnRewardPerEpoch(epoch), p.MomentumProducingZnnRewardPercentage)
+	return delegation.Quo(delegation, momentums), producing.Quo(producing, momentums)
 }
 
 // SentinelRewardForEpoch returns sentinel Znn and Qsr reward for a specific epoch.
-func SentinelRewardForEpoch(epoch uint64) (*big.Int, *big.Int) {
-	znn := (NetworkZnnRewardPerEpoch(epoch) * SentinelZnnRewardPercentage) / 100
-	qsr := (NetworkQsrRewardPerEpoch(epoch) * SentinelQsrRewardPercentage) / 100
-	return big.NewInt(znn), big.NewInt(qsr)
+func (p *ChainParams) SentinelRewardForEpoch(epoch uint64) (*big.Int, *big.Int) {
+	znn := rewardPercentage(p.NetworkZnnRewardPerEpoch(epoch), p.SentinelZnnRewardPercentage)
+	qsr := rewardPercentage(p.NetworkQsrRewardPerEpoch(epoch), p.SentinelQsrRewardPercentage)
+	return znn, qsr
 }
 
 // LiquidityRewardForEpoch returns liquidity Znn and Qsr reward for a specific epoch.
-func LiquidityRewardForEpoch(epoch uint64) (*big.Int, *big.Int) {
-	znn := (NetworkZnnRewardPerEpoch(epoch) * LiquidityZnnRewardPercentage) / 100
-	qsr := (NetworkQsrRewardPerEpoch(epoch) * LiquidityQsrRewardPercentage) / 100
-	return big.NewInt(znn), big.NewInt(qsr)
+func (p *ChainParams) LiquidityRewardForEpoch(epoch uint64) (*big.Int, *big.Int) {
+	znn := rewardPercentage(p.NetworkZnnRewardPerEpoch(epoch), p.LiquidityZnnRewardPercentage)
+	qsr := rewardPercentage(p.NetworkQsrRewardPerEpoch(epoch), p.LiquidityQsrRewardPercentage)
+	return znn, qsr
 }
 
 // StakeQsrRewardPerEpoch returns staking Qsr reward for a specific epoch
-func StakeQsrRewardPerEpoch(epoch uint64) *big.Int {
-	qsr := (NetworkQsrRewardPerEpoch(epoch) * StakingQsrRewardPercentage) / 100
-	return big.NewInt(qsr)
+func (p *ChainParams) StakeQsrRewardPerEpoch(epoch uint64) *big.Int {
+	return rewardPercentage(p.NetworkQsrRewardPerEpoch(epoch), p.StakingQsrRewardPercentage)
+}
+
+// CumulatedNetworkRewards returns the Znn and Qsr emitted by the network from epoch 0 up to and including the given epoch.
+func (p *ChainParams) CumulatedNetworkRewards(epoch uint64) (*big.Int, *big.Int) {
+	return cumulatedReward(p.NetworkZnnRewardConfig, p.RewardTickDurationInEpochs, epoch), cumulatedReward(p.NetworkQsrRewardConfig, p.RewardTickDurationInEpochs, epoch)
+}
+
+func cumulatedReward(config []*big.Int, tickDuration uint64, epoch uint64) *big.Int {
+	total := new(big.Int)
+	remaining := epoch + 1
+	for i, reward := range config {
+		duration := tickDuration
+		// the last tick is emitted forever
+		if i == len(config)-1 || remaining < duration {
+			duration = remaining
+		}
+		total.Add(total, new(big.Int).Mul(reward, new(big.Int).SetUint64(duration)))
+		remaining -= duration
+		if remaining == 0 {
+			break
+		}
+	}
+	return total
 }
@@ -12,6 +12,7 @@ var (
 	ErrNotContractAddress     = errors.New("not a contract address")
 	ErrContractDoesntExist    = errors.New("contract doesn't exist")
 	ErrContractMethodNotFound = errors.New("method not found in the abi")
+	ErrContractDisabled       = errors.New("contract is disabled on this chain")
 	ErrDataNonExistent        = errors.New("data non existent")
 	ErrUnpackError            = errors.New("invalid unpack method data")
 	ErrInsufficientBalance    = errors.New("insufficient balance for transfer")
@@ -60,6 +61,10 @@ var (
 	// Spork
 	ErrAlreadyActivated = errors.New("spork is already activated")
 
+	// Authority
+	ErrNotAuthorityAdmin = errors.New("sender is not an authority administrator")
+	ErrAlreadyVoted      = errors.New("administrator already voted for this proposal")
+
 	// Htlc
 	ReclaimNotDue            = errors.New("entry is not expired")
 	ErrInvalidHashType       = errors.New("invalid hash type")
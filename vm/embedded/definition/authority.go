@@ -0,0 +1,239 @@
+package definition
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/abi"
+	"github.com/zenon-network/go-zenon/vm/constants"
+)
+
+const (
+	jsonAuthority = `
+	[
+		{"type":"function","name":"AddAuthority","inputs":[
+			{"name":"name","type":"string"},
+			{"name":"producerAddress","type":"address"}
+		]},
+		{"type":"function","name":"RemoveAuthority","inputs":[
+			{"name":"name","type":"string"}
+		]},
+		{"type":"function","name":"ChangeAuthorityAdmins","inputs":[
+			{"name":"admins","type":"address[]"},
+			{"name":"threshold","type":"uint8"}
+		]},
+
+		{"type":"variable","name":"authorityInfo","inputs":[
+			{"name":"name","type":"string"},
+			{"name":"producerAddress","type":"address"},
+			{"name":"registrationTime","type":"int64"}
+		]},
+		{"type":"variable","name":"authorityAdmins","inputs":[
+			{"name":"admins","type":"address[]"},
+			{"name":"threshold","type":"uint8"}
+		]},
+		{"type":"variable","name":"authorityProposal","inputs":[
+			{"name":"votes","type":"address[]"},
+			{"name":"expirationHeight","type":"uint64"}
+		]}
+	]`
+
+	AddAuthorityMethodName          = "AddAuthority"
+	RemoveAuthorityMethodName       = "RemoveAuthority"
+	ChangeAuthorityAdminsMethodName = "ChangeAuthorityAdmins"
+
+	authorityInfoVariableName     = "authorityInfo"
+	authorityAdminsVariableName   = "authorityAdmins"
+	authorityProposalVariableName = "authorityProposal"
+)
+
+var (
+	// ABIAuthority is abi definition of authority contract
+	ABIAuthority = abi.JSONToABIContract(strings.NewReader(jsonAuthority))
+
+	authorityInfoKeyPrefix     = []byte{1}
+	authorityAdminsKey         = []byte{2}
+	authorityProposalKeyPrefix = []byte{3}
+)
+
+type AddAuthorityParam struct {
+	Name            string
+	ProducerAddress types.Address
+}
+type ChangeAuthorityAdminsParam struct {
+	Admins    []types.Address
+	Threshold uint8
+}
+
+// AuthorityInfo is a producer of a proof of authority chain
+type AuthorityInfo struct {
+	Name             string        `json:"name"`
+	ProducerAddress  types.Address `json:"producerAddress"`
+	RegistrationTime int64         `json:"registrationTime"`
+}
+
+func (authority *AuthorityInfo) Save(context db.DB) error {
+	data, err := ABIAuthority.PackVariable(
+		authorityInfoVariableName,
+		authority.Name,
+		authority.ProducerAddress,
+		authority.RegistrationTime,
+	)
+	if err != nil {
+		return err
+	}
+	return context.Put(getAuthorityInfoKey(authority.Name), data)
+}
+func (authority *AuthorityInfo) Delete(context db.DB) error {
+	return context.Delete(getAuthorityInfoKey(authority.Name))
+}
+
+func getAuthorityInfoKey(name string) []byte {
+	return common.JoinBytes(authorityInfoKeyPrefix, types.NewHash([]byte(name)).Bytes())
+}
+func parseAuthorityInfo(data []byte) (*AuthorityInfo, error) {
+	if len(data) > 0 {
+		authority := new(AuthorityInfo)
+		if err := ABIAuthority.UnpackVariable(authority, authorityInfoVariableName, data); err != nil {
+			return nil, err
+		}
+		return authority, nil
+	} else {
+		return nil, constants.ErrDataNonExistent
+	}
+}
+func GetAuthorityInfo(context db.DB, name string) (*AuthorityInfo, error) {
+	if data, err := context.Get(getAuthorityInfoKey(name)); err != nil {
+		return nil, err
+	} else {
+		return parseAuthorityInfo(data)
+	}
+}
+
+// GetAuthorityList returns the authorities sorted by name
+func GetAuthorityList(context db.DB) ([]*AuthorityInfo, error) {
+	iterator := context.NewIterator(authorityInfoKeyPrefix)
+	defer iterator.Release()
+	list := make([]*AuthorityInfo, 0)
+	for {
+		if !iterator.Next() {
+			if iterator.Error() != nil {
+				return nil, iterator.Error()
+			}
+			break
+		}
+
+		if authority, err := parseAuthorityInfo(iterator.Value()); err == nil {
+			list = append(list, authority)
+		} else if err == constants.ErrDataNonExistent {
+			continue
+		} else {
+			return nil, err
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+// AuthorityAdmins are the addresses which manage the authorities.
+// A change is applied once Threshold of the Admins send the same call to the contract.
+type AuthorityAdmins struct {
+	Admins    []types.Address `json:"admins"`
+	Threshold uint8           `json:"threshold"`
+}
+
+func (admins *AuthorityAdmins) Save(context db.DB) error {
+	data, err := ABIAuthority.PackVariable(
+		authorityAdminsVariableName,
+		admins.Admins,
+		admins.Threshold,
+	)
+	if err != nil {
+		return err
+	}
+	return context.Put(authorityAdminsKey, data)
+}
+func (admins *AuthorityAdmins) IsAdmin(address types.Address) bool {
+	for _, admin := range admins.Admins {
+		if admin == address {
+			return true
+		}
+	}
+	return false
+}
+func GetAuthorityAdmins(context db.DB) (*AuthorityAdmins, error) {
+	data, err := context.Get(authorityAdminsKey)
+	if err != nil {
+		return nil, err
+	}
+	admins := &AuthorityAdmins{
+		Admins: make([]types.Address, 0),
+	}
+	if len(data) == 0 {
+		return admins, nil
+	}
+	if err := ABIAuthority.UnpackVariable(admins, authorityAdminsVariableName, data); err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
+// AuthorityProposal holds the admins which sent the same call, identified by the hash of the call data.
+// The votes are dropped once the momentum at ExpirationHeight is reached.
+type AuthorityProposal struct {
+	Id               types.Hash      `json:"id"`
+	Votes            []types.Address `json:"votes"`
+	ExpirationHeight uint64          `json:"expirationHeight"`
+}
+
+func (proposal *AuthorityProposal) Save(context db.DB) error {
+	data, err := ABIAuthority.PackVariable(
+		authorityProposalVariableName,
+		proposal.Votes,
+		proposal.ExpirationHeight,
+	)
+	if err != nil {
+		return err
+	}
+	return context.Put(getAuthorityProposalKey(proposal.Id), data)
+}
+func (proposal *AuthorityProposal) Delete(context db.DB) error {
+	return context.Delete(getAuthorityProposalKey(proposal.Id))
+}
+
+// CountVotes returns the number of votes which belong to current admins
+func (proposal *AuthorityProposal) CountVotes(admins *AuthorityAdmins) int {
+	count := 0
+	for _, vote := range proposal.Votes {
+		if admins.IsAdmin(vote) {
+			count += 1
+		}
+	}
+	return count
+}
+
+func getAuthorityProposalKey(id types.Hash) []byte {
+	return common.JoinBytes(authorityProposalKeyPrefix, id.Bytes())
+}
+func GetAuthorityProposal(context db.DB, id types.Hash) (*AuthorityProposal, error) {
+	data, err := context.Get(getAuthorityProposalKey(id))
+	if err != nil {
+		return nil, err
+	}
+	proposal := &AuthorityProposal{
+		Id:    id,
+		Votes: make([]types.Address, 0),
+	}
+	if len(data) == 0 {
+		return proposal, nil
+	}
+	if err := ABIAuthority.UnpackVariable(proposal, authorityProposalVariableName, data); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
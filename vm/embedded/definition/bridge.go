@@ -302,7 +302,7 @@ func (b *BridgeInfoVariable) Save(context db.DB) error {
 		data,
 	)
 }
-func parseBridgeInfoVariable(data []byte) (*BridgeInfoVariable, error) {
+func parseBridgeInfoVariable(data []byte, params *constants.ChainParams) (*BridgeInfoVariable, error) {
 	if len(data) > 0 {
 		bridgeInfo := new(BridgeInfoVariable)
 		if err := ABIBridge.UnpackVariable(bridgeInfo, bridgeInfoVariableName, data); err != nil {
@@ -316,17 +316,17 @@ func parseBridgeInfoVariable(data []byte) (*BridgeInfoVariable, error) {
 			DecompressedTssECDSAPubKey: "",
 			AllowKeyGen:                false,
 			Halted:                     false,
-			UnhaltDurationInMomentums:  constants.MinUnhaltDurationInMomentums,
+			UnhaltDurationInMomentums:  params.MinUnhaltDurationInMomentums,
 			TssNonce:                   0,
 			Metadata:                   "{}",
 		}, nil
 	}
 }
-func GetBridgeInfoVariable(context db.DB) (*BridgeInfoVariable, error) {
+func GetBridgeInfoVariable(context db.DB, params *constants.ChainParams) (*BridgeInfoVariable, error) {
 	if data, err := context.Get(BridgeInfoKeyPrefix); err != nil {
 		return nil, err
 	} else {
-		upd, err := parseBridgeInfoVariable(data)
+		upd, err := parseBridgeInfoVariable(data, params)
 		return upd, err
 	}
 }
@@ -676,7 +676,7 @@ func (s *SecurityInfoVariable) Save(context db.DB) error {
 		data,
 	)
 }
-func parseSecurityInfoVariable(data []byte) (*SecurityInfoVariable, error) {
+func parseSecurityInfoVariable(data []byte, params *constants.ChainParams) (*SecurityInfoVariable, error) {
 	if len(data) > 0 {
 		SecurityInfo := new(SecurityInfoVariable)
 		if err := ABICommon.UnpackVariable(SecurityInfo, securityInfoVariableName, data); err != nil {
@@ -687,16 +687,16 @@ func parseSecurityInfoVariable(data []byte) (*SecurityInfoVariable, error) {
 		return &SecurityInfoVariable{
 			Guardians:          make([]types.Address, 0),
 			GuardiansVotes:     make([]types.Address, 0),
-			AdministratorDelay: constants.MinAdministratorDelay,
-			SoftDelay:          constants.MinSoftDelay,
+			AdministratorDelay: params.MinAdministratorDelay,
+			SoftDelay:          params.MinSoftDelay,
 		}, nil
 	}
 }
-func GetSecurityInfoVariable(context db.DB) (*SecurityInfoVariable, error) {
+func GetSecurityInfoVariable(context db.DB, params *constants.ChainParams) (*SecurityInfoVariable, error) {
 	if data, err := context.Get(SecurityInfoKeyPrefix); err != nil {
 		return nil, err
 	} else {
-		upd, err := parseSecurityInfoVariable(data)
+		upd, err := parseSecurityInfoVariable(data, params)
 		return upd, err
 	}
 }
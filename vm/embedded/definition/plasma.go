@@ -146,6 +146,31 @@ func GetFusionInfoListByOwner(context db.DB, owner types.Address) ([]*FusionInfo
 	return list, fusedAmount, nil
 }
 
+func IterateFusionEntries(context db.DB, f func(*FusionInfo) error) error {
+	iterator := context.NewIterator(fusionInfoKeyPrefix)
+	defer iterator.Release()
+
+	for {
+		if !iterator.Next() {
+			if iterator.Error() != nil {
+				return iterator.Error()
+			}
+			break
+		}
+
+		if fusionInfo, err := parseFusionInfo(iterator.Key(), iterator.Value()); err == nil {
+			if err := f(fusionInfo); err != nil {
+				return err
+			}
+		} else if err == constants.ErrDataNonExistent {
+			continue
+		} else {
+			return err
+		}
+	}
+	return nil
+}
+
 type FusedAmount struct {
 	Beneficiary types.Address
 	Amount      *big.Int
@@ -14,6 +14,12 @@ const (
 	[
 		{"type":"function","name":"CreateSpork","inputs":[{"name":"name","type":"string"},{"name":"description","type":"string"}]},
 		{"type":"function","name":"ActivateSpork","inputs":[{"name":"id","type":"hash"}]},
+		{"type":"function","name":"CreateConsensusSpork","inputs":[
+			{"name":"name","type":"string"},
+			{"name":"description","type":"string"},
+			{"name":"nodeCount","type":"uint8"},
+			{"name":"randCount","type":"uint8"}
+		]},
 
 		{"type":"variable", "name":"sporkInfo", "inputs":[
 			{"name":"id", "type":"hash"},
@@ -21,13 +27,19 @@ const (
 			{"name":"description", "type":"string"},
 			{"name":"activated", "type": "bool"},
 			{"name":"enforcementHeight", "type": "uint64"}
+		]},
+		{"type":"variable", "name":"sporkConsensusParameters", "inputs":[
+			{"name":"nodeCount", "type":"uint8"},
+			{"name":"randCount", "type":"uint8"}
 		]}
 	]`
 
-	SporkCreateMethodName   = "CreateSpork"
-	SporkActivateMethodName = "ActivateSpork"
+	SporkCreateMethodName          = "CreateSpork"
+	SporkActivateMethodName        = "ActivateSpork"
+	SporkCreateConsensusMethodName = "CreateConsensusSpork"
 
-	sporkInfoVariableName = "sporkInfo"
+	sporkInfoVariableName                = "sporkInfo"
+	sporkConsensusParametersVariableName = "sporkConsensusParameters"
 )
 
 var (
@@ -38,6 +50,7 @@ var (
 const (
 	_ byte = iota
 	sporkInfoPrefix
+	sporkConsensusParametersPrefix
 )
 
 type Spork struct {
@@ -99,3 +112,65 @@ func GetAllSporks(context db.DB) []*Spork {
 	}
 	return sporks
 }
+
+type CreateConsensusSporkParam struct {
+	Name        string
+	Description string
+	NodeCount   uint8
+	RandCount   uint8
+}
+
+// SporkConsensusParameters is the payload of a consensus spork, with the same id as the spork.
+// The consensus parameters change at the start of the first epoch after the momentum at the enforcement height of the spork.
+// Only the committee size changes, the block time stays the one of the genesis since the windows counted in momentums assume it.
+type SporkConsensusParameters struct {
+	Id        types.Hash `json:"id"`
+	NodeCount uint8      `json:"nodeCount"`
+	RandCount uint8      `json:"randCount"`
+}
+
+func (parameters *SporkConsensusParameters) Save(context db.DB) {
+	common.DealWithErr(context.Put(parameters.Key(), parameters.Data()))
+}
+func (parameters *SporkConsensusParameters) Data() []byte {
+	return ABISpork.PackVariablePanic(
+		sporkConsensusParametersVariableName,
+		parameters.NodeCount,
+		parameters.RandCount)
+}
+func (parameters *SporkConsensusParameters) Key() []byte {
+	return common.JoinBytes([]byte{sporkConsensusParametersPrefix}, parameters.Id.Bytes())
+}
+
+func parseSporkConsensusParameters(key, data []byte) *SporkConsensusParameters {
+	parameters := new(SporkConsensusParameters)
+	ABISpork.UnpackVariablePanic(parameters, sporkConsensusParametersVariableName, data)
+	parameters.Id = types.BytesToHashPanic(key[1:])
+	return parameters
+}
+
+func GetSporkConsensusParametersById(context db.DB, id types.Hash) *SporkConsensusParameters {
+	parameters := &SporkConsensusParameters{Id: id}
+	key := parameters.Key()
+	data, err := context.Get(key)
+	common.DealWithErr(err)
+	if len(data) == 0 {
+		return nil
+	} else {
+		return parseSporkConsensusParameters(key, data)
+	}
+}
+func GetAllSporkConsensusParameters(context db.DB) []*SporkConsensusParameters {
+	iterator := context.NewIterator([]byte{sporkConsensusParametersPrefix})
+	defer iterator.Release()
+
+	list := make([]*SporkConsensusParameters, 0)
+	for {
+		if !iterator.Next() {
+			common.DealWithErr(iterator.Error())
+			break
+		}
+		list = append(list, parseSporkConsensusParameters(iterator.Key(), iterator.Value()))
+	}
+	return list
+}
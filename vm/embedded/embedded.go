@@ -18,7 +18,7 @@ type Method interface {
 
 	// ValidateSendBlock is called as a static check on send-blocks.
 	// All send blocks need to pass this verification before being added in the chain.
-	ValidateSendBlock(block *nom.AccountBlock) error
+	ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error
 
 	// ReceiveBlock is called to generate the descendant blocks and to apply the sendBlock
 	// The actual receive-block is generated in the VM.
@@ -26,6 +26,18 @@ type Method interface {
 	ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error)
 }
 
+// disabledMethod wraps the methods of the embedded contracts which are not enabled in the genesis
+type disabledMethod struct {
+	Method
+}
+
+func (p *disabledMethod) ValidateSendBlock(*constants.ChainParams, *nom.AccountBlock) error {
+	return constants.ErrContractDisabled
+}
+func (p *disabledMethod) ReceiveBlock(vm_context.AccountVmContext, *nom.AccountBlock) ([]*nom.AccountBlock, error) {
+	return nil, constants.ErrContractDisabled
+}
+
 type embeddedImplementation struct {
 	m   map[string]Method
 	abi abi.ABIContract
@@ -106,6 +118,22 @@ func applyAcceleratorDiffs(contracts map[types.Address]*embeddedImplementation)
 	contracts[types.LiquidityContract].m[cabi.BurnZnnMethodName] = &implementation.BurnZnnMethod{MethodName: cabi.BurnZnnMethodName}
 }
 
+func applyConsensusParametersDiffs(contracts map[types.Address]*embeddedImplementation) {
+	contracts[types.SporkContract].m[cabi.SporkCreateConsensusMethodName] = &implementation.CreateConsensusSporkMethod{MethodName: cabi.SporkCreateConsensusMethodName}
+}
+
+// applyAuthorityDiffs adds the authority contract, which exists only on proof of authority chains
+func applyAuthorityDiffs(contracts map[types.Address]*embeddedImplementation) {
+	contracts[types.AuthorityContract] = &embeddedImplementation{
+		map[string]Method{
+			cabi.AddAuthorityMethodName:          &implementation.AddAuthorityMethod{MethodName: cabi.AddAuthorityMethodName},
+			cabi.RemoveAuthorityMethodName:       &implementation.RemoveAuthorityMethod{MethodName: cabi.RemoveAuthorityMethodName},
+			cabi.ChangeAuthorityAdminsMethodName: &implementation.ChangeAuthorityAdminsMethod{MethodName: cabi.ChangeAuthorityAdminsMethodName},
+		},
+		cabi.ABIAuthority,
+	}
+}
+
 func getOrigin() map[types.Address]*embeddedImplementation {
 	return map[types.Address]*embeddedImplementation{
 		types.PlasmaContract: {
@@ -219,6 +247,12 @@ func GetEmbeddedMethod(context vm_context.AccountVmContext, address types.Addres
 		applyHtlcDiffs(contractsMap)
 	}
 	// No change for NoPillarRegSpork
+	if context.IsConsensusParametersSporkEnforced() {
+		applyConsensusParametersDiffs(contractsMap)
+	}
+	if context.ChainParams().Consensus.Mode == constants.ProofOfAuthority {
+		applyAuthorityDiffs(contractsMap)
+	}
 
 	// contract address must exist in map
 	if p, found := contractsMap[address]; found {
@@ -226,6 +260,9 @@ func GetEmbeddedMethod(context vm_context.AccountVmContext, address types.Addres
 		if method, err := p.abi.MethodById(abiSelector); err == nil {
 			// method must exist in the map
 			c, ok := p.m[method.Name]
+			if ok && !context.ChainParams().IsContractEnabled(address) {
+				return &disabledMethod{c}, nil
+			}
 			if ok {
 				return c, nil
 			}
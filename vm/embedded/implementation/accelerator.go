@@ -29,7 +29,7 @@ func IsAcceleratorRunning(context vm_context.AccountVmContext) error {
 	return nil
 }
 
-func checkMetaDataStatic(param *definition.AcceleratorParam) error {
+func checkMetaDataStatic(params *constants.ChainParams, param *definition.AcceleratorParam) error {
 	if len(param.Name) == 0 ||
 		len(param.Name) > constants.ProjectNameLengthMax {
 		return constants.ErrInvalidName
@@ -43,7 +43,7 @@ func checkMetaDataStatic(param *definition.AcceleratorParam) error {
 		return constants.ErrForbiddenParam
 	}
 
-	if param.ZnnFundsNeeded.Cmp(constants.ProjectZnnMaximumFunds) > 0 || param.QsrFundsNeeded.Cmp(constants.ProjectQsrMaximumFunds) > 0 {
+	if param.ZnnFundsNeeded.Cmp(params.ProjectZnnMaximumFunds) > 0 || param.QsrFundsNeeded.Cmp(params.ProjectQsrMaximumFunds) > 0 {
 		return constants.ErrAcceleratorInvalidFunds
 	}
 
@@ -79,7 +79,7 @@ func checkPhaseFunds(context vm_context.AccountVmContext, project *definition.Pr
 		}
 		znnPhaseFunds.Add(znnPhaseFunds, phase.ZnnFundsNeeded)
 	}
-	if znnPhaseFunds.Cmp(project.ZnnFundsNeeded) == +1 || project.ZnnFundsNeeded.Cmp(constants.ProjectZnnMaximumFunds) == +1 {
+	if znnPhaseFunds.Cmp(project.ZnnFundsNeeded) == +1 || project.ZnnFundsNeeded.Cmp(context.ChainParams().ProjectZnnMaximumFunds) == +1 {
 		return constants.ErrAcceleratorInvalidFunds
 	}
 
@@ -94,7 +94,7 @@ func checkPhaseFunds(context vm_context.AccountVmContext, project *definition.Pr
 		}
 		qsrPhaseFunds.Add(qsrPhaseFunds, phase.QsrFundsNeeded)
 	}
-	if qsrPhaseFunds.Cmp(project.QsrFundsNeeded) == +1 || project.QsrFundsNeeded.Cmp(constants.ProjectQsrMaximumFunds) == +1 {
+	if qsrPhaseFunds.Cmp(project.QsrFundsNeeded) == +1 || project.QsrFundsNeeded.Cmp(context.ChainParams().ProjectQsrMaximumFunds) == +1 {
 		return constants.ErrAcceleratorInvalidFunds
 	}
 	return nil
@@ -107,7 +107,7 @@ type CreateProjectMethod struct {
 func (p *CreateProjectMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *CreateProjectMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CreateProjectMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.AcceleratorParam)
 
@@ -115,12 +115,12 @@ func (p *CreateProjectMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return constants.ErrUnpackError
 	}
 
-	if err := checkMetaDataStatic(param); err != nil {
+	if err := checkMetaDataStatic(params, param); err != nil {
 		return err
 	}
 
 	// the cost to create an accelerated project is 1 znn
-	if block.TokenStandard != types.ZnnTokenStandard || block.Amount.Cmp(constants.ProjectCreationAmount) != 0 {
+	if block.TokenStandard != params.ZnnTokenStandard || block.Amount.Cmp(params.ProjectCreationAmount) != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -128,7 +128,7 @@ func (p *CreateProjectMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *CreateProjectMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	if err := IsAcceleratorRunning(context); err != nil {
@@ -174,7 +174,7 @@ func (p *AddPhaseMethod) Fee() (*big.Int, error) {
 func (p *AddPhaseMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *AddPhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *AddPhaseMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.AcceleratorParam)
 
@@ -182,7 +182,7 @@ func (p *AddPhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return constants.ErrUnpackError
 	}
 
-	if err := checkMetaDataStatic(param); err != nil {
+	if err := checkMetaDataStatic(params, param); err != nil {
 		return err
 	}
 
@@ -190,7 +190,7 @@ func (p *AddPhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *AddPhaseMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	if err := IsAcceleratorRunning(context); err != nil {
@@ -259,7 +259,7 @@ func checkAcceleratorVotes(context vm_context.AccountVmContext, id types.Hash, n
 		ok = false
 	}
 	// Test enough votes
-	if breakdown.Total*100 <= numPillars*constants.VoteAcceptanceThreshold {
+	if breakdown.Total*100 <= numPillars*context.ChainParams().VoteAcceptanceThreshold {
 		ok = false
 	}
 
@@ -274,7 +274,7 @@ type UpdateEmbeddedAcceleratorMethod struct {
 func (p *UpdateEmbeddedAcceleratorMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *UpdateEmbeddedAcceleratorMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdateEmbeddedAcceleratorMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIAccelerator.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -289,7 +289,7 @@ func (p *UpdateEmbeddedAcceleratorMethod) ValidateSendBlock(block *nom.AccountBl
 	return err
 }
 func (p *UpdateEmbeddedAcceleratorMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -317,12 +317,12 @@ func (p *UpdateEmbeddedAcceleratorMethod) ReceiveBlock(context vm_context.Accoun
 	}
 
 	blocks := make([]*nom.AccountBlock, 0)
-	balanceZnn, err := context.GetBalance(types.ZnnTokenStandard)
+	balanceZnn, err := context.GetBalance(context.ChainParams().ZnnTokenStandard)
 	if err != nil {
 		return nil, err
 	}
 	znnBalance := new(big.Int).Set(balanceZnn)
-	balanceQsr, err := context.GetBalance(types.QsrTokenStandard)
+	balanceQsr, err := context.GetBalance(context.ChainParams().QsrTokenStandard)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +347,7 @@ func (p *UpdateEmbeddedAcceleratorMethod) ReceiveBlock(context vm_context.Accoun
 	for _, project := range projectList {
 		if project.Status == definition.VotingStatus {
 			// Check if project voting period has ended
-			if project.CreationTimestamp+constants.AcceleratorProjectVotingPeriod >= frontierMomentum.Timestamp.Unix() {
+			if project.CreationTimestamp+context.ChainParams().AcceleratorProjectVotingPeriod >= frontierMomentum.Timestamp.Unix() {
 				ok := checkAcceleratorVotes(context, project.Id, numPillars)
 				acceleratorLog.Debug("project passed voting period", "project-id", project.Id, "passed-votes", ok)
 				if ok {
@@ -378,7 +378,7 @@ func (p *UpdateEmbeddedAcceleratorMethod) ReceiveBlock(context vm_context.Accoun
 							ToAddress:     project.Owner,
 							BlockType:     nom.BlockTypeContractSend,
 							Amount:        phase.ZnnFundsNeeded,
-							TokenStandard: types.ZnnTokenStandard,
+							TokenStandard: context.ChainParams().ZnnTokenStandard,
 							Data:          phase.Id.Bytes(),
 						}
 					} else {
@@ -391,7 +391,7 @@ func (p *UpdateEmbeddedAcceleratorMethod) ReceiveBlock(context vm_context.Accoun
 							ToAddress:     project.Owner,
 							BlockType:     nom.BlockTypeContractSend,
 							Amount:        phase.QsrFundsNeeded,
-							TokenStandard: types.QsrTokenStandard,
+							TokenStandard: context.ChainParams().QsrTokenStandard,
 							Data:          phase.Id.Bytes(),
 						}
 					} else {
@@ -431,7 +431,7 @@ type UpdatePhaseMethod struct {
 func (p *UpdatePhaseMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdatePhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdatePhaseMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.AcceleratorParam)
 
@@ -439,7 +439,7 @@ func (p *UpdatePhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return constants.ErrUnpackError
 	}
 
-	if err := checkMetaDataStatic(param); err != nil {
+	if err := checkMetaDataStatic(params, param); err != nil {
 		return err
 	}
 
@@ -447,7 +447,7 @@ func (p *UpdatePhaseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UpdatePhaseMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	if err := IsAcceleratorRunning(context); err != nil {
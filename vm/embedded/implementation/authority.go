@@ -0,0 +1,254 @@
+package implementation
+
+import (
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/vm/vm_context"
+)
+
+var (
+	authorityLog = common.EmbeddedLogger.New("contract", "authority")
+)
+
+func checkAuthorityAdmin(context vm_context.AccountVmContext, address types.Address) error {
+	admins, err := definition.GetAuthorityAdmins(context.Storage())
+	if err != nil {
+		return err
+	}
+	if !admins.IsAdmin(address) {
+		return constants.ErrNotAuthorityAdmin
+	}
+	return nil
+}
+
+// voteAuthorityProposal records the vote of the sender, which must be an admin, for the call identified by the hash of its data.
+// Returns true if the call reached the threshold of the admins and must be applied, the proposal is deleted in that case.
+func voteAuthorityProposal(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) (bool, error) {
+	admins, err := definition.GetAuthorityAdmins(context.Storage())
+	if err != nil {
+		return false, err
+	}
+	momentum, err := context.GetFrontierMomentum()
+	common.DealWithErr(err)
+
+	proposal, err := definition.GetAuthorityProposal(context.Storage(), types.NewHash(sendBlock.Data))
+	if err != nil {
+		return false, err
+	}
+	// a new or expired proposal starts over with the vote of the sender
+	if len(proposal.Votes) == 0 || proposal.ExpirationHeight <= momentum.Height {
+		proposal.Votes = proposal.Votes[:0]
+		proposal.ExpirationHeight = momentum.Height + uint64(context.ChainParams().MomentumsPerEpoch)*constants.AuthorityProposalExpirationEpochs
+	}
+	for _, vote := range proposal.Votes {
+		if vote == sendBlock.Address {
+			return false, constants.ErrAlreadyVoted
+		}
+	}
+	proposal.Votes = append(proposal.Votes, sendBlock.Address)
+
+	if proposal.CountVotes(admins) < int(admins.Threshold) {
+		common.DealWithErr(proposal.Save(context.Storage()))
+		authorityLog.Debug("voted proposal", "id", proposal.Id, "admin", sendBlock.Address, "votes", len(proposal.Votes), "threshold", admins.Threshold)
+		return false, nil
+	}
+	common.DealWithErr(proposal.Delete(context.Storage()))
+	return true, nil
+}
+
+func checkAuthorityAdminsStatic(param *definition.ChangeAuthorityAdminsParam) error {
+	if len(param.Admins) == 0 || len(param.Admins) > constants.AuthorityAdminsMaxNum {
+		return constants.ErrInvalidArguments
+	}
+	if param.Threshold == 0 || int(param.Threshold) > len(param.Admins) {
+		return constants.ErrInvalidArguments
+	}
+	unique := make(map[types.Address]bool, len(param.Admins))
+	for _, admin := range param.Admins {
+		if admin.IsZero() || unique[admin] {
+			return constants.ErrForbiddenParam
+		}
+		unique[admin] = true
+	}
+	return nil
+}
+
+type AddAuthorityMethod struct {
+	MethodName string
+}
+
+func (p *AddAuthorityMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
+	return plasmaTable.EmbeddedSimple, nil
+}
+func (p *AddAuthorityMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	var err error
+	param := new(definition.AddAuthorityParam)
+	if err := definition.ABIAuthority.UnpackMethod(param, p.MethodName, block.Data); err != nil {
+		return constants.ErrUnpackError
+	}
+
+	if block.Amount.Sign() != 0 {
+		return constants.ErrInvalidTokenOrAmount
+	}
+	if err := checkPillarNameStatic(param.Name); err != nil {
+		return err
+	}
+	if param.ProducerAddress.IsZero() || types.IsEmbeddedAddress(param.ProducerAddress) {
+		return constants.ErrForbiddenParam
+	}
+
+	block.Data, err = definition.ABIAuthority.PackMethod(p.MethodName, param.Name, param.ProducerAddress)
+	return err
+}
+func (p *AddAuthorityMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
+		authorityLog.Debug("invalid add authority - syntactic validation failed", "address", sendBlock.Address, "reason", err)
+		return nil, err
+	}
+	if err := checkAuthorityAdmin(context, sendBlock.Address); err != nil {
+		return nil, err
+	}
+
+	param := new(definition.AddAuthorityParam)
+	err := definition.ABIAuthority.UnpackMethod(param, p.MethodName, sendBlock.Data)
+	common.DealWithErr(err)
+
+	authorities, err := definition.GetAuthorityList(context.Storage())
+	common.DealWithErr(err)
+	if len(authorities) >= constants.AuthorityMaxNum {
+		return nil, constants.ErrNotEnoughSlots
+	}
+	for _, authority := range authorities {
+		if authority.Name == param.Name || authority.ProducerAddress == param.ProducerAddress {
+			return nil, constants.ErrNotUnique
+		}
+	}
+
+	apply, err := voteAuthorityProposal(context, sendBlock)
+	if err != nil || !apply {
+		return nil, err
+	}
+
+	momentum, err := context.GetFrontierMomentum()
+	common.DealWithErr(err)
+	authority := &definition.AuthorityInfo{
+		Name:             param.Name,
+		ProducerAddress:  param.ProducerAddress,
+		RegistrationTime: momentum.Timestamp.Unix(),
+	}
+	common.DealWithErr(authority.Save(context.Storage()))
+	authorityLog.Debug("added authority", "authority", authority)
+	return nil, nil
+}
+
+type RemoveAuthorityMethod struct {
+	MethodName string
+}
+
+func (p *RemoveAuthorityMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
+	return plasmaTable.EmbeddedSimple, nil
+}
+func (p *RemoveAuthorityMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	var err error
+	name := new(string)
+	if err := definition.ABIAuthority.UnpackMethod(name, p.MethodName, block.Data); err != nil {
+		return constants.ErrUnpackError
+	}
+
+	if block.Amount.Sign() != 0 {
+		return constants.ErrInvalidTokenOrAmount
+	}
+	if err := checkPillarNameStatic(*name); err != nil {
+		return err
+	}
+
+	block.Data, err = definition.ABIAuthority.PackMethod(p.MethodName, *name)
+	return err
+}
+func (p *RemoveAuthorityMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
+		authorityLog.Debug("invalid remove authority - syntactic validation failed", "address", sendBlock.Address, "reason", err)
+		return nil, err
+	}
+	if err := checkAuthorityAdmin(context, sendBlock.Address); err != nil {
+		return nil, err
+	}
+
+	name := new(string)
+	err := definition.ABIAuthority.UnpackMethod(name, p.MethodName, sendBlock.Data)
+	common.DealWithErr(err)
+
+	authority, err := definition.GetAuthorityInfo(context.Storage(), *name)
+	if err != nil {
+		return nil, err
+	}
+	// the chain stops without producers
+	authorities, err := definition.GetAuthorityList(context.Storage())
+	common.DealWithErr(err)
+	if len(authorities) == 1 {
+		return nil, constants.ErrForbiddenParam
+	}
+
+	apply, err := voteAuthorityProposal(context, sendBlock)
+	if err != nil || !apply {
+		return nil, err
+	}
+
+	common.DealWithErr(authority.Delete(context.Storage()))
+	authorityLog.Debug("removed authority", "authority", authority)
+	return nil, nil
+}
+
+type ChangeAuthorityAdminsMethod struct {
+	MethodName string
+}
+
+func (p *ChangeAuthorityAdminsMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
+	return plasmaTable.EmbeddedSimple, nil
+}
+func (p *ChangeAuthorityAdminsMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	var err error
+	param := new(definition.ChangeAuthorityAdminsParam)
+	if err := definition.ABIAuthority.UnpackMethod(param, p.MethodName, block.Data); err != nil {
+		return constants.ErrUnpackError
+	}
+
+	if block.Amount.Sign() != 0 {
+		return constants.ErrInvalidTokenOrAmount
+	}
+	if err := checkAuthorityAdminsStatic(param); err != nil {
+		return err
+	}
+
+	block.Data, err = definition.ABIAuthority.PackMethod(p.MethodName, param.Admins, param.Threshold)
+	return err
+}
+func (p *ChangeAuthorityAdminsMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
+		authorityLog.Debug("invalid change authority admins - syntactic validation failed", "address", sendBlock.Address, "reason", err)
+		return nil, err
+	}
+	if err := checkAuthorityAdmin(context, sendBlock.Address); err != nil {
+		return nil, err
+	}
+
+	param := new(definition.ChangeAuthorityAdminsParam)
+	err := definition.ABIAuthority.UnpackMethod(param, p.MethodName, sendBlock.Data)
+	common.DealWithErr(err)
+
+	apply, err := voteAuthorityProposal(context, sendBlock)
+	if err != nil || !apply {
+		return nil, err
+	}
+
+	admins := &definition.AuthorityAdmins{
+		Admins:    param.Admins,
+		Threshold: param.Threshold,
+	}
+	common.DealWithErr(admins.Save(context.Storage()))
+	authorityLog.Debug("changed admins", "admins", admins.Admins, "threshold", admins.Threshold)
+	return nil, nil
+}
@@ -76,7 +76,7 @@ func CanPerformAction(context vm_context.AccountVmContext) (*definition.BridgeIn
 }
 
 func CheckBridgeInitialized(context vm_context.AccountVmContext) (*definition.BridgeInfoVariable, error) {
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +139,7 @@ type WrapTokenMethod struct {
 func (p *WrapTokenMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *WrapTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *WrapTokenMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.WrapTokenParam)
 
@@ -159,7 +159,7 @@ func (p *WrapTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *WrapTokenMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	param := new(definition.WrapTokenParam)
@@ -274,7 +274,7 @@ type UpdateWrapRequestMethod struct {
 func (p *UpdateWrapRequestMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdateWrapRequestMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdateWrapRequestMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.UpdateWrapRequestParam)
 
@@ -290,7 +290,7 @@ func (p *UpdateWrapRequestMethod) ValidateSendBlock(block *nom.AccountBlock) err
 	return err
 }
 func (p *UpdateWrapRequestMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -385,7 +385,7 @@ type UnwrapTokenMethod struct {
 func (p *UnwrapTokenMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UnwrapTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UnwrapTokenMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.UnwrapTokenParam)
 
@@ -406,7 +406,7 @@ func (p *UnwrapTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UnwrapTokenMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	bridgeInfo, _, err := CanPerformAction(context)
@@ -487,7 +487,7 @@ type SetNetworkMethod struct {
 func (p *SetNetworkMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetNetworkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetNetworkMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.NetworkInfoParam)
 
@@ -519,7 +519,7 @@ func (p *SetNetworkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *SetNetworkMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -529,7 +529,7 @@ func (p *SetNetworkMethod) ReceiveBlock(context vm_context.AccountVmContext, sen
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -565,7 +565,7 @@ type RemoveNetworkMethod struct {
 func (p *RemoveNetworkMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *RemoveNetworkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RemoveNetworkMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.NetworkInfoParam)
 
@@ -581,7 +581,7 @@ func (p *RemoveNetworkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *RemoveNetworkMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -591,7 +591,7 @@ func (p *RemoveNetworkMethod) ReceiveBlock(context vm_context.AccountVmContext,
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -622,7 +622,7 @@ type SetNetworkMetadataMethod struct {
 func (p *SetNetworkMetadataMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetNetworkMetadataMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetNetworkMetadataMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.SetNetworkMetadataParam)
@@ -642,7 +642,7 @@ func (p *SetNetworkMetadataMethod) ValidateSendBlock(block *nom.AccountBlock) er
 	return err
 }
 func (p *SetNetworkMetadataMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -652,7 +652,7 @@ func (p *SetNetworkMetadataMethod) ReceiveBlock(context vm_context.AccountVmCont
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -689,7 +689,7 @@ type SetTokenPairMethod struct {
 func (p *SetTokenPairMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetTokenPairMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetTokenPairMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.TokenPairParam)
 
@@ -701,7 +701,7 @@ func (p *SetTokenPairMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
-	if (param.TokenStandard.String() == types.ZnnTokenStandard.String() || param.TokenStandard.String() == types.QsrTokenStandard.String()) &&
+	if (param.TokenStandard.String() == params.ZnnTokenStandard.String() || param.TokenStandard.String() == params.QsrTokenStandard.String()) &&
 		param.Owned {
 		return constants.ErrForbiddenParam
 	}
@@ -730,7 +730,7 @@ func (p *SetTokenPairMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *SetTokenPairMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +740,7 @@ func (p *SetTokenPairMethod) ReceiveBlock(context vm_context.AccountVmContext, s
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -756,7 +756,7 @@ func (p *SetTokenPairMethod) ReceiveBlock(context vm_context.AccountVmContext, s
 		return nil, constants.ErrUnknownNetwork
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -812,7 +812,7 @@ type RemoveTokenPairMethod struct {
 func (p *RemoveTokenPairMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *RemoveTokenPairMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RemoveTokenPairMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.TokenPairParam)
 
@@ -832,7 +832,7 @@ func (p *RemoveTokenPairMethod) ValidateSendBlock(block *nom.AccountBlock) error
 	return err
 }
 func (p *RemoveTokenPairMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -842,7 +842,7 @@ func (p *RemoveTokenPairMethod) ReceiveBlock(context vm_context.AccountVmContext
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -906,7 +906,7 @@ type HaltMethod struct {
 func (p *HaltMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *HaltMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *HaltMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	signature := new(string)
@@ -922,7 +922,7 @@ func (p *HaltMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *HaltMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -932,7 +932,7 @@ func (p *HaltMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 		return nil, err
 	}
 
-	bridgeInfo, errBridge := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, errBridge := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if errBridge != nil {
 		return nil, errBridge
 	}
@@ -968,7 +968,7 @@ type UnhaltMethod struct {
 func (p *UnhaltMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UnhaltMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UnhaltMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	if err := definition.ABIBridge.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
 		return constants.ErrUnpackError
@@ -982,7 +982,7 @@ func (p *UnhaltMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UnhaltMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -990,7 +990,7 @@ func (p *UnhaltMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlo
 	if err != nil {
 		return nil, err
 	}
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1020,7 +1020,7 @@ type EmergencyMethod struct {
 func (p *EmergencyMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *EmergencyMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *EmergencyMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	if err := definition.ABIBridge.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
 		return constants.ErrUnpackError
@@ -1034,7 +1034,7 @@ func (p *EmergencyMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *EmergencyMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1043,7 +1043,7 @@ func (p *EmergencyMethod) ReceiveBlock(context vm_context.AccountVmContext, send
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1105,7 +1105,7 @@ type ChangeTssECDSAPubKeyMethod struct {
 func (p *ChangeTssECDSAPubKeyMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ChangeTssECDSAPubKeyMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ChangeTssECDSAPubKeyMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.ChangeECDSAPubKeyParam)
 	if err = definition.ABIBridge.UnpackMethod(param, p.MethodName, block.Data); err != nil {
@@ -1127,7 +1127,7 @@ func (p *ChangeTssECDSAPubKeyMethod) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *ChangeTssECDSAPubKeyMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1144,7 +1144,7 @@ func (p *ChangeTssECDSAPubKeyMethod) ReceiveBlock(context vm_context.AccountVmCo
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1185,7 +1185,7 @@ func (p *ChangeTssECDSAPubKeyMethod) ReceiveBlock(context vm_context.AccountVmCo
 
 		bridgeInfo.TssNonce += 1
 	} else {
-		securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+		securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 		if err != nil {
 			return nil, err
 		}
@@ -1214,7 +1214,7 @@ type ChangeAdministratorMethod struct {
 func (p *ChangeAdministratorMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ChangeAdministratorMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ChangeAdministratorMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	address := new(types.Address)
 	if err = definition.ABIBridge.UnpackMethod(address, p.MethodName, block.Data); err != nil {
@@ -1237,7 +1237,7 @@ func (p *ChangeAdministratorMethod) ValidateSendBlock(block *nom.AccountBlock) e
 	return err
 }
 func (p *ChangeAdministratorMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1247,7 +1247,7 @@ func (p *ChangeAdministratorMethod) ReceiveBlock(context vm_context.AccountVmCon
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1285,7 +1285,7 @@ type SetAllowKeygenMethod struct {
 func (p *SetAllowKeygenMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetAllowKeygenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetAllowKeygenMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	var param bool
@@ -1301,7 +1301,7 @@ func (p *SetAllowKeygenMethod) ValidateSendBlock(block *nom.AccountBlock) error
 	return err
 }
 func (p *SetAllowKeygenMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1310,7 +1310,7 @@ func (p *SetAllowKeygenMethod) ReceiveBlock(context vm_context.AccountVmContext,
 		return nil, constants.ErrUnpackError
 	}
 
-	bridgeInfo, errBridge := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, errBridge := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if errBridge != nil {
 		return nil, errBridge
 	}
@@ -1352,7 +1352,7 @@ type SetOrchestratorInfoMethod struct {
 func (p *SetOrchestratorInfoMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetOrchestratorInfoMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetOrchestratorInfoMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.OrchestratorInfoParam)
@@ -1372,7 +1372,7 @@ func (p *SetOrchestratorInfoMethod) ValidateSendBlock(block *nom.AccountBlock) e
 	return err
 }
 func (p *SetOrchestratorInfoMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1383,7 +1383,7 @@ func (p *SetOrchestratorInfoMethod) ReceiveBlock(context vm_context.AccountVmCon
 	}
 
 	// the only condition is that bridge is not nil, which means the administrator pub key was set
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1412,7 +1412,7 @@ type SetBridgeMetadataMethod struct {
 func (p *SetBridgeMetadataMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetBridgeMetadataMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetBridgeMetadataMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(string)
@@ -1432,7 +1432,7 @@ func (p *SetBridgeMetadataMethod) ValidateSendBlock(block *nom.AccountBlock) err
 	return err
 }
 func (p *SetBridgeMetadataMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1442,7 +1442,7 @@ func (p *SetBridgeMetadataMethod) ReceiveBlock(context vm_context.AccountVmConte
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1463,7 +1463,7 @@ type RevokeUnwrapRequestMethod struct {
 func (p *RevokeUnwrapRequestMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *RevokeUnwrapRequestMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RevokeUnwrapRequestMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.RevokeUnwrapParam)
@@ -1479,7 +1479,7 @@ func (p *RevokeUnwrapRequestMethod) ValidateSendBlock(block *nom.AccountBlock) e
 	return err
 }
 func (p *RevokeUnwrapRequestMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1489,7 +1489,7 @@ func (p *RevokeUnwrapRequestMethod) ReceiveBlock(context vm_context.AccountVmCon
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1515,7 +1515,7 @@ type RedeemMethod struct {
 func (p *RedeemMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *RedeemMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RedeemMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.RedeemParam)
 
@@ -1531,7 +1531,7 @@ func (p *RedeemMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *RedeemMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1623,7 +1623,7 @@ type NominateGuardiansMethod struct {
 func (p *NominateGuardiansMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *NominateGuardiansMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *NominateGuardiansMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	guardians := new([]types.Address)
@@ -1652,7 +1652,7 @@ func (p *NominateGuardiansMethod) ValidateSendBlock(block *nom.AccountBlock) err
 	return err
 }
 func (p *NominateGuardiansMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1662,7 +1662,7 @@ func (p *NominateGuardiansMethod) ReceiveBlock(context vm_context.AccountVmConte
 		return nil, err
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1671,7 +1671,7 @@ func (p *NominateGuardiansMethod) ReceiveBlock(context vm_context.AccountVmConte
 		return nil, constants.ErrPermissionDenied
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1713,7 +1713,7 @@ type ProposeAdministratorMethod struct {
 func (p *ProposeAdministratorMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ProposeAdministratorMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ProposeAdministratorMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	address := new(types.Address)
@@ -1737,7 +1737,7 @@ func (p *ProposeAdministratorMethod) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *ProposeAdministratorMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1746,7 +1746,7 @@ func (p *ProposeAdministratorMethod) ReceiveBlock(context vm_context.AccountVmCo
 		return nil, constants.ErrUnpackError
 	}
 
-	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage())
+	bridgeInfo, err := definition.GetBridgeInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1755,7 +1755,7 @@ func (p *ProposeAdministratorMethod) ReceiveBlock(context vm_context.AccountVmCo
 		return nil, constants.ErrNotEmergency
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
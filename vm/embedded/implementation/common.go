@@ -30,7 +30,7 @@ func CanPerformUpdate(context vm_context.AccountVmContext) error {
 		return err
 	}
 
-	if lastUpdate.Height+constants.UpdateMinNumMomentums <= currentHeight {
+	if lastUpdate.Height+context.ChainParams().UpdateMinNumMomentums <= currentHeight {
 		return nil
 	} else {
 		return constants.ErrUpdateTooRecent
@@ -108,7 +108,7 @@ func (p *CollectRewardMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uin
 	// in case of sentinels it issues 2 rewards, but it's not called enough to cause issues
 	return p.Plasma, nil
 }
-func (p *CollectRewardMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CollectRewardMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABICommon.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -123,7 +123,7 @@ func (p *CollectRewardMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *CollectRewardMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -141,10 +141,10 @@ func (p *CollectRewardMethod) ReceiveBlock(context vm_context.AccountVmContext,
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        big.NewInt(0),
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.ZnnTokenStandard,
+				context.ChainParams().ZnnTokenStandard,
 				deposit.Znn,
 				sendBlock.Address,
 			),
@@ -156,10 +156,10 @@ func (p *CollectRewardMethod) ReceiveBlock(context vm_context.AccountVmContext,
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        big.NewInt(0),
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.QsrTokenStandard,
+				context.ChainParams().QsrTokenStandard,
 				deposit.Qsr,
 				sendBlock.Address,
 			),
@@ -200,14 +200,14 @@ type DepositQsrMethod struct {
 func (p *DepositQsrMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *DepositQsrMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *DepositQsrMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABICommon.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
 		return constants.ErrUnpackError
 	}
 
-	if block.TokenStandard != types.QsrTokenStandard || block.Amount.Sign() != 1 {
+	if block.TokenStandard != params.QsrTokenStandard || block.Amount.Sign() != 1 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -215,7 +215,7 @@ func (p *DepositQsrMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *DepositQsrMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -234,7 +234,7 @@ type WithdrawQsrMethod struct {
 func (p *WithdrawQsrMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *WithdrawQsrMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *WithdrawQsrMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABICommon.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -249,7 +249,7 @@ func (p *WithdrawQsrMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *WithdrawQsrMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -268,7 +268,7 @@ func (p *WithdrawQsrMethod) ReceiveBlock(context vm_context.AccountVmContext, se
 			ToAddress:     *qsrDeposit.Address,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        qsrDeposit.Qsr,
-			TokenStandard: types.QsrTokenStandard,
+			TokenStandard: context.ChainParams().QsrTokenStandard,
 			Data:          []byte{},
 		},
 	}, nil
@@ -281,7 +281,7 @@ type DonateMethod struct {
 func (p *DonateMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *DonateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *DonateMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABICommon.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -296,7 +296,7 @@ func (p *DonateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *DonateMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 	commonLog.Info("received donation", "embedded", sendBlock.ToAddress, "from-address", sendBlock.Address, "zts", sendBlock.TokenStandard, "amount", sendBlock.Amount)
@@ -313,7 +313,7 @@ func (p *VoteByNameMethod) Fee() (*big.Int, error) {
 func (p *VoteByNameMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *VoteByNameMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *VoteByNameMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.PillarVote)
@@ -333,7 +333,7 @@ func (p *VoteByNameMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *VoteByNameMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -379,7 +379,7 @@ func (p *VoteByProdAddressMethod) Fee() (*big.Int, error) {
 func (p *VoteByProdAddressMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *VoteByProdAddressMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *VoteByProdAddressMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.PillarVote)
@@ -399,7 +399,7 @@ func (p *VoteByProdAddressMethod) ValidateSendBlock(block *nom.AccountBlock) err
 	return err
 }
 func (p *VoteByProdAddressMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -474,7 +474,7 @@ func TimeChallenge(context vm_context.AccountVmContext, methodName string, hash
 }
 
 func CheckSecurityInitialized(context vm_context.AccountVmContext) (*definition.SecurityInfoVariable, error) {
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ type CreateHtlcMethod struct {
 func (p *CreateHtlcMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *CreateHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CreateHtlcMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.CreateHtlcParam)
@@ -83,7 +83,7 @@ func (p *CreateHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *CreateHtlcMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		htlcLog.Debug("invalid create - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -125,7 +125,7 @@ type ReclaimHtlcMethod struct {
 func (p *ReclaimHtlcMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *ReclaimHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ReclaimHtlcMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(types.Hash)
 
@@ -141,7 +141,7 @@ func (p *ReclaimHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *ReclaimHtlcMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		htlcLog.Debug("invalid reclaim - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -194,7 +194,7 @@ type UnlockHtlcMethod struct {
 func (p *UnlockHtlcMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *UnlockHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UnlockHtlcMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.UnlockHtlcParam)
 
@@ -210,7 +210,7 @@ func (p *UnlockHtlcMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UnlockHtlcMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		htlcLog.Debug("invalid unlock - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -285,7 +285,7 @@ func (p *DenyHtlcProxyUnlockMethod) GetPlasma(plasmaTable *constants.PlasmaTable
 	return plasmaTable.EmbeddedSimple, nil
 }
 
-func (p *DenyHtlcProxyUnlockMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *DenyHtlcProxyUnlockMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIHtlc.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -301,7 +301,7 @@ func (p *DenyHtlcProxyUnlockMethod) ValidateSendBlock(block *nom.AccountBlock) e
 }
 
 func (p *DenyHtlcProxyUnlockMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		htlcLog.Debug("invalid create - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -324,7 +324,7 @@ func (p *AllowHtlcProxyUnlockMethod) GetPlasma(plasmaTable *constants.PlasmaTabl
 	return plasmaTable.EmbeddedSimple, nil
 }
 
-func (p *AllowHtlcProxyUnlockMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *AllowHtlcProxyUnlockMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIHtlc.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -340,7 +340,7 @@ func (p *AllowHtlcProxyUnlockMethod) ValidateSendBlock(block *nom.AccountBlock)
 }
 
 func (p *AllowHtlcProxyUnlockMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		htlcLog.Debug("invalid create - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -26,7 +26,7 @@ type UpdateEmbeddedLiquidityMethod struct {
 func (method *UpdateEmbeddedLiquidityMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (method *UpdateEmbeddedLiquidityMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (method *UpdateEmbeddedLiquidityMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABILiquidity.UnpackEmptyMethod(method.MethodName, block.Data); err != nil {
@@ -41,7 +41,7 @@ func (method *UpdateEmbeddedLiquidityMethod) ValidateSendBlock(block *nom.Accoun
 	return err
 }
 func (method *UpdateEmbeddedLiquidityMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := method.ValidateSendBlock(sendBlock); err != nil {
+	if err := method.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		liquidityLog.Debug("invalid update - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (method *UpdateEmbeddedLiquidityMethod) ReceiveBlock(context vm_context.Acc
 }
 
 func computeLiquidityRewardsForEpoch(context vm_context.AccountVmContext, epoch uint64) ([]*nom.AccountBlock, error) {
-	totalZnnAmount, totalQsrAmount := constants.LiquidityRewardForEpoch(epoch)
+	totalZnnAmount, totalQsrAmount := context.ChainParams().LiquidityRewardForEpoch(epoch)
 
 	liquidityLog.Debug("updating liquidity reward", "epoch", epoch, "znn-amount", totalZnnAmount, "qsr-amount", totalQsrAmount)
 
@@ -66,7 +66,7 @@ func computeLiquidityRewardsForEpoch(context vm_context.AccountVmContext, epoch
 			Amount:    common.Big0,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.ZnnTokenStandard,
+				context.ChainParams().ZnnTokenStandard,
 				totalZnnAmount,
 				types.LiquidityContract,
 			),
@@ -76,7 +76,7 @@ func computeLiquidityRewardsForEpoch(context vm_context.AccountVmContext, epoch
 			Amount:    common.Big0,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.QsrTokenStandard,
+				context.ChainParams().QsrTokenStandard,
 				totalQsrAmount,
 				types.LiquidityContract,
 			),
@@ -118,8 +118,8 @@ func (p *FundMethod) Fee() (*big.Int, error) {
 func (p *FundMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *FundMethod) ValidateSendBlock(block *nom.AccountBlock) error {
-	if block.Address != *types.SporkAddress {
+func (p *FundMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	if block.Address != *params.SporkAddress {
 		return constants.ErrPermissionDenied
 	}
 
@@ -134,7 +134,7 @@ func (p *FundMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *FundMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -144,11 +144,11 @@ func (p *FundMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 
 	blocks := make([]*nom.AccountBlock, 0)
 	if context.IsAcceleratorSporkEnforced() {
-		znnBalance, err := context.GetBalance(types.ZnnTokenStandard)
+		znnBalance, err := context.GetBalance(context.ChainParams().ZnnTokenStandard)
 		if err != nil {
 			return nil, err
 		}
-		qsrBalance, err := context.GetBalance(types.QsrTokenStandard)
+		qsrBalance, err := context.GetBalance(context.ChainParams().QsrTokenStandard)
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +157,7 @@ func (p *FundMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 				Address:       types.LiquidityContract,
 				ToAddress:     types.AcceleratorContract,
 				Data:          definition.ABICommon.PackMethodPanic(definition.DonateMethodName),
-				TokenStandard: types.ZnnTokenStandard,
+				TokenStandard: context.ChainParams().ZnnTokenStandard,
 				Amount:        param.ZnnReward,
 			}
 			blocks = append(blocks, znnReward)
@@ -166,7 +166,7 @@ func (p *FundMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 				Address:       types.LiquidityContract,
 				ToAddress:     types.AcceleratorContract,
 				Data:          definition.ABICommon.PackMethodPanic(definition.DonateMethodName),
-				TokenStandard: types.QsrTokenStandard,
+				TokenStandard: context.ChainParams().QsrTokenStandard,
 				Amount:        param.QsrReward,
 			}
 			blocks = append(blocks, qsrReward)
@@ -189,8 +189,8 @@ func (p *BurnZnnMethod) Fee() (*big.Int, error) {
 func (p *BurnZnnMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *BurnZnnMethod) ValidateSendBlock(block *nom.AccountBlock) error {
-	if block.Address != *types.SporkAddress {
+func (p *BurnZnnMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	if block.Address != *params.SporkAddress {
 		return constants.ErrPermissionDenied
 	}
 
@@ -205,7 +205,7 @@ func (p *BurnZnnMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *BurnZnnMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -215,7 +215,7 @@ func (p *BurnZnnMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBl
 
 	blocks := make([]*nom.AccountBlock, 0)
 	if context.IsAcceleratorSporkEnforced() {
-		znnBalance, err := context.GetBalance(types.ZnnTokenStandard)
+		znnBalance, err := context.GetBalance(context.ChainParams().ZnnTokenStandard)
 		if err != nil {
 			return nil, err
 		}
@@ -224,7 +224,7 @@ func (p *BurnZnnMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBl
 				Address:       types.LiquidityContract,
 				ToAddress:     types.TokenContract,
 				Data:          definition.ABIToken.PackMethodPanic(definition.BurnMethodName),
-				TokenStandard: types.ZnnTokenStandard,
+				TokenStandard: context.ChainParams().ZnnTokenStandard,
 				Amount:        param.BurnAmount,
 			}
 			blocks = append(blocks, burnBlock)
@@ -244,7 +244,7 @@ type SetTokenTupleMethod struct {
 func (p *SetTokenTupleMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetTokenTupleMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetTokenTupleMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.TokenTuplesParam)
 
@@ -293,7 +293,7 @@ func (p *SetTokenTupleMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *SetTokenTupleMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -331,7 +331,7 @@ func (p *SetTokenTupleMethod) ReceiveBlock(context vm_context.AccountVmContext,
 		return nil, err
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -354,8 +354,8 @@ type LiquidityStakeMethod struct {
 	MethodName string
 }
 
-func getWeightedLiquidityStakeAmount(amount *big.Int, stakingTime int64) *big.Int {
-	period := stakingTime / constants.StakeTimeUnitSec
+func getWeightedLiquidityStakeAmount(params *constants.ChainParams, amount *big.Int, stakingTime int64) *big.Int {
+	period := stakingTime / params.StakeTimeUnitSec
 	weighted := big.NewInt(constants.LiquidityStakeWeights[period])
 	weighted.Mul(weighted, amount)
 	return weighted
@@ -364,7 +364,7 @@ func getWeightedLiquidityStakeAmount(amount *big.Int, stakingTime int64) *big.In
 func (p *LiquidityStakeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *LiquidityStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *LiquidityStakeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	var stakeTime int64
 
@@ -372,7 +372,7 @@ func (p *LiquidityStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error
 		return constants.ErrUnpackError
 	}
 
-	if stakeTime < constants.StakeTimeMinSec || stakeTime > constants.StakeTimeMaxSec || stakeTime%constants.StakeTimeUnitSec != 0 {
+	if stakeTime < params.StakeTimeMinSec || stakeTime > params.StakeTimeMaxSec || stakeTime%params.StakeTimeUnitSec != 0 {
 		return constants.ErrInvalidStakingPeriod
 	}
 
@@ -380,7 +380,7 @@ func (p *LiquidityStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error
 	return err
 }
 func (p *LiquidityStakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -411,7 +411,7 @@ func (p *LiquidityStakeMethod) ReceiveBlock(context vm_context.AccountVmContext,
 	stakeEntry := definition.LiquidityStakeEntry{
 		Amount:         sendBlock.Amount,
 		TokenStandard:  sendBlock.TokenStandard,
-		WeightedAmount: getWeightedLiquidityStakeAmount(sendBlock.Amount, stakeTime),
+		WeightedAmount: getWeightedLiquidityStakeAmount(context.ChainParams(), sendBlock.Amount, stakeTime),
 		StartTime:      momentum.Timestamp.Unix(),
 		RevokeTime:     0,
 		ExpirationTime: momentum.Timestamp.Unix() + stakeTime,
@@ -431,7 +431,7 @@ type CancelLiquidityStakeMethod struct {
 func (p *CancelLiquidityStakeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *CancelLiquidityStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CancelLiquidityStakeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	id := new(types.Hash)
 	if err := definition.ABILiquidity.UnpackMethod(id, p.MethodName, block.Data); err != nil {
@@ -446,7 +446,7 @@ func (p *CancelLiquidityStakeMethod) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *CancelLiquidityStakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -494,7 +494,7 @@ type UpdateRewardEmbeddedLiquidityMethod struct {
 func (method *UpdateRewardEmbeddedLiquidityMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (method *UpdateRewardEmbeddedLiquidityMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (method *UpdateRewardEmbeddedLiquidityMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABILiquidity.UnpackEmptyMethod(method.MethodName, block.Data); err != nil {
@@ -509,7 +509,7 @@ func (method *UpdateRewardEmbeddedLiquidityMethod) ValidateSendBlock(block *nom.
 	return err
 }
 func (method *UpdateRewardEmbeddedLiquidityMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := method.ValidateSendBlock(sendBlock); err != nil {
+	if err := method.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		liquidityLog.Debug("invalid update - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -543,7 +543,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 	if err != nil {
 		return nil, err
 	}
-	totalZnnAmount, totalQsrAmount := constants.LiquidityRewardForEpoch(epoch)
+	totalZnnAmount, totalQsrAmount := context.ChainParams().LiquidityRewardForEpoch(epoch)
 	if liquidityInfo.IsHalted {
 		// return blocks that issue tokens to liquidity embedded
 		return []*nom.AccountBlock{
@@ -552,7 +552,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 				Amount:    common.Big0,
 				Data: definition.ABIToken.PackMethodPanic(
 					definition.MintMethodName,
-					types.ZnnTokenStandard,
+					context.ChainParams().ZnnTokenStandard,
 					totalZnnAmount,
 					types.LiquidityContract,
 				),
@@ -562,7 +562,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 				Amount:    common.Big0,
 				Data: definition.ABIToken.PackMethodPanic(
 					definition.MintMethodName,
-					types.QsrTokenStandard,
+					context.ChainParams().QsrTokenStandard,
 					totalQsrAmount,
 					types.LiquidityContract,
 				),
@@ -570,11 +570,11 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 		}, nil
 	}
 
-	znnBalance, err := context.GetBalance(types.ZnnTokenStandard)
+	znnBalance, err := context.GetBalance(context.ChainParams().ZnnTokenStandard)
 	if err != nil {
 		return nil, err
 	}
-	qsrBalance, err := context.GetBalance(types.QsrTokenStandard)
+	qsrBalance, err := context.GetBalance(context.ChainParams().QsrTokenStandard)
 	if err != nil {
 		return nil, err
 	}
@@ -586,7 +586,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 				Address:       types.LiquidityContract,
 				ToAddress:     types.TokenContract,
 				Data:          definition.ABIToken.PackMethodPanic(definition.BurnMethodName),
-				TokenStandard: types.ZnnTokenStandard,
+				TokenStandard: context.ChainParams().ZnnTokenStandard,
 				Amount:        liquidityInfo.ZnnReward,
 			}
 			blocks = append(blocks, znnBurnBlock)
@@ -599,7 +599,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 				Address:       types.LiquidityContract,
 				ToAddress:     types.TokenContract,
 				Data:          definition.ABIToken.PackMethodPanic(definition.BurnMethodName),
-				TokenStandard: types.QsrTokenStandard,
+				TokenStandard: context.ChainParams().QsrTokenStandard,
 				Amount:        liquidityInfo.QsrReward,
 			}
 			blocks = append(blocks, qsrBurnBlock)
@@ -693,7 +693,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 			Amount:    common.Big0,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.ZnnTokenStandard,
+				context.ChainParams().ZnnTokenStandard,
 				znnReward,
 				types.LiquidityContract,
 			),
@@ -708,7 +708,7 @@ func computeLiquidityStakeRewardsForEpoch(context vm_context.AccountVmContext, e
 			Amount:    common.Big0,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.QsrTokenStandard,
+				context.ChainParams().QsrTokenStandard,
 				qsrReward,
 				types.LiquidityContract,
 			),
@@ -748,7 +748,7 @@ type SetIsHalted struct {
 func (p *SetIsHalted) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetIsHalted) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetIsHalted) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(bool)
@@ -764,7 +764,7 @@ func (p *SetIsHalted) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *SetIsHalted) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -797,7 +797,7 @@ type UnlockLiquidityStakeEntries struct {
 func (p *UnlockLiquidityStakeEntries) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UnlockLiquidityStakeEntries) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UnlockLiquidityStakeEntries) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABILiquidity.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -812,7 +812,7 @@ func (p *UnlockLiquidityStakeEntries) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *UnlockLiquidityStakeEntries) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -845,7 +845,7 @@ type SetAdditionalReward struct {
 func (p *SetAdditionalReward) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *SetAdditionalReward) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SetAdditionalReward) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	param := new(definition.SetAdditionalRewardParam)
@@ -861,7 +861,7 @@ func (p *SetAdditionalReward) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *SetAdditionalReward) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -891,7 +891,7 @@ func (p *SetAdditionalReward) ReceiveBlock(context vm_context.AccountVmContext,
 	}
 	paramsHash := crypto.Hash(messageBytes)
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -921,7 +921,7 @@ type ChangeAdministratorLiquidity struct {
 func (p *ChangeAdministratorLiquidity) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ChangeAdministratorLiquidity) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ChangeAdministratorLiquidity) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	address := new(types.Address)
@@ -945,7 +945,7 @@ func (p *ChangeAdministratorLiquidity) ValidateSendBlock(block *nom.AccountBlock
 	return err
 }
 func (p *ChangeAdministratorLiquidity) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -968,7 +968,7 @@ func (p *ChangeAdministratorLiquidity) ReceiveBlock(context vm_context.AccountVm
 		return nil, constants.ErrPermissionDenied
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1002,7 +1002,7 @@ type NominateGuardiansLiquidity struct {
 func (p *NominateGuardiansLiquidity) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *NominateGuardiansLiquidity) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *NominateGuardiansLiquidity) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	guardians := new([]types.Address)
@@ -1031,7 +1031,7 @@ func (p *NominateGuardiansLiquidity) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *NominateGuardiansLiquidity) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1050,7 +1050,7 @@ func (p *NominateGuardiansLiquidity) ReceiveBlock(context vm_context.AccountVmCo
 		return nil, constants.ErrPermissionDenied
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1092,7 +1092,7 @@ type ProposeAdministratorLiquidity struct {
 func (p *ProposeAdministratorLiquidity) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ProposeAdministratorLiquidity) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ProposeAdministratorLiquidity) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	address := new(types.Address)
@@ -1116,7 +1116,7 @@ func (p *ProposeAdministratorLiquidity) ValidateSendBlock(block *nom.AccountBloc
 	return err
 }
 func (p *ProposeAdministratorLiquidity) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -1134,7 +1134,7 @@ func (p *ProposeAdministratorLiquidity) ReceiveBlock(context vm_context.AccountV
 		return nil, constants.ErrNotEmergency
 	}
 
-	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage())
+	securityInfo, err := definition.GetSecurityInfoVariable(context.Storage(), context.ChainParams())
 	if err != nil {
 		return nil, err
 	}
@@ -1193,7 +1193,7 @@ type EmergencyLiquidity struct {
 func (p *EmergencyLiquidity) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *EmergencyLiquidity) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *EmergencyLiquidity) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	if err := definition.ABILiquidity.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
 		return constants.ErrUnpackError
@@ -1207,7 +1207,7 @@ func (p *EmergencyLiquidity) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *EmergencyLiquidity) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -3,7 +3,6 @@ package implementation
 import (
 	"encoding/base64"
 	"math/big"
-	"regexp"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -27,7 +26,7 @@ func checkPillarNameStatic(name string) error {
 		len(name) > constants.PillarNameLengthMax {
 		return constants.ErrInvalidName
 	}
-	if ok, _ := regexp.MatchString("^([a-zA-Z0-9]+[-._]?)*[a-zA-Z0-9]$", name); !ok {
+	if !constants.PillarNameRegExp.MatchString(name) {
 		return constants.ErrInvalidName
 	}
 	return nil
@@ -94,7 +93,7 @@ func checkAndRegisterPillar(context vm_context.AccountVmContext, param *definiti
 	pillar.BlockProducingAddress = param.ProducerAddress
 	pillar.RewardWithdrawAddress = param.RewardAddress
 	pillar.StakeAddress = ownerAddress
-	pillar.Amount = constants.PillarStakeAmount
+	pillar.Amount = context.ChainParams().PillarStakeAmount
 	pillar.RegistrationTime = momentum.Timestamp.Unix()
 	pillar.GiveBlockRewardPercentage = param.GiveBlockRewardPercentage
 	pillar.GiveDelegateRewardPercentage = param.GiveDelegateRewardPercentage
@@ -117,9 +116,9 @@ func GetQsrCostForNextPillar(context vm_context.AccountVmContext) (*big.Int, err
 	numPillars := len(pillarsList)
 
 	currentCost := new(big.Int)
-	currentCost.Set(constants.PillarQsrStakeIncreaseAmount)
+	currentCost.Set(context.ChainParams().PillarQsrStakeIncreaseAmount)
 	currentCost.Mul(currentCost, big.NewInt(int64(numPillars)))
-	currentCost.Add(currentCost, constants.PillarQsrStakeBaseAmount)
+	currentCost.Add(currentCost, context.ChainParams().PillarQsrStakeBaseAmount)
 	return currentCost, nil
 }
 
@@ -128,12 +127,12 @@ func GetQsrCostForNextPillar(context vm_context.AccountVmContext) (*big.Int, err
 // - true, timeWhileCanRevoke
 // If Pillar *can't* be revoked, returns
 // - false, timeUntilCanRevoke
-func PillarGetRevokeStatus(old *definition.PillarInfo, m *nom.Momentum) (bool, int64) {
-	epochTime := (m.Timestamp.Unix() - old.RegistrationTime) % (constants.PillarEpochLockTime + constants.PillarEpochRevokeTime)
-	if epochTime < constants.PillarEpochLockTime {
-		return false, constants.PillarEpochLockTime - epochTime
+func PillarGetRevokeStatus(params *constants.ChainParams, old *definition.PillarInfo, m *nom.Momentum) (bool, int64) {
+	epochTime := (m.Timestamp.Unix() - old.RegistrationTime) % (params.PillarEpochLockTime + params.PillarEpochRevokeTime)
+	if epochTime < params.PillarEpochLockTime {
+		return false, params.PillarEpochLockTime - epochTime
 	} else {
-		return true, (constants.PillarEpochLockTime + constants.PillarEpochRevokeTime) - epochTime
+		return true, (params.PillarEpochLockTime + params.PillarEpochRevokeTime) - epochTime
 	}
 }
 
@@ -145,7 +144,7 @@ func (p *RegisterMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64,
 	// include burn transaction
 	return 2 * plasmaTable.EmbeddedSimple, nil
 }
-func (p *RegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RegisterMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.RegisterParam)
 
@@ -161,7 +160,7 @@ func (p *RegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	}
 	// check amount of znn in block required for registration
 	// qsr amount is deposited in the embedded and it cannot be checked static
-	if block.TokenStandard != types.ZnnTokenStandard || block.Amount.Cmp(constants.PillarStakeAmount) != 0 {
+	if block.TokenStandard != params.ZnnTokenStandard || block.Amount.Cmp(params.PillarStakeAmount) != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -169,7 +168,7 @@ func (p *RegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *RegisterMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -197,7 +196,7 @@ func (p *RegisterMethod) ReceiveBlock(context vm_context.AccountVmContext, sendB
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        requiredPillarQsrAmount,
-			TokenStandard: types.QsrTokenStandard,
+			TokenStandard: context.ChainParams().QsrTokenStandard,
 			Data:          definition.ABIToken.PackMethodPanic(definition.BurnMethodName),
 		},
 	}, nil
@@ -211,7 +210,7 @@ func (p *LegacyRegisterMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (ui
 	// include burn transaction
 	return 2 * plasmaTable.EmbeddedSimple, nil
 }
-func (p *LegacyRegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *LegacyRegisterMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.LegacyRegisterParam)
 
@@ -231,7 +230,7 @@ func (p *LegacyRegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error
 	}
 	// check amount of znn in block required for registration
 	// qsr amount is deposited in the embedded and it cannot be checked static
-	if block.TokenStandard != types.ZnnTokenStandard || block.Amount.Cmp(constants.PillarStakeAmount) != 0 {
+	if block.TokenStandard != params.ZnnTokenStandard || block.Amount.Cmp(params.PillarStakeAmount) != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -239,7 +238,7 @@ func (p *LegacyRegisterMethod) ValidateSendBlock(block *nom.AccountBlock) error
 	return err
 }
 func (p *LegacyRegisterMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -267,7 +266,7 @@ func (p *LegacyRegisterMethod) ReceiveBlock(context vm_context.AccountVmContext,
 		common.DealWithErr(legacyEntry.Save(context.Storage()))
 	}
 
-	requiredPillarQsrAmount := constants.PillarQsrStakeBaseAmount
+	requiredPillarQsrAmount := context.ChainParams().PillarQsrStakeBaseAmount
 
 	if err := checkAndRegisterPillar(context, &param.RegisterParam, sendBlock.Address, definition.LegacyPillarType); err != nil {
 		return nil, err
@@ -282,7 +281,7 @@ func (p *LegacyRegisterMethod) ReceiveBlock(context vm_context.AccountVmContext,
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        requiredPillarQsrAmount,
-			TokenStandard: types.QsrTokenStandard,
+			TokenStandard: context.ChainParams().QsrTokenStandard,
 			Data:          definition.ABIToken.PackMethodPanic(definition.BurnMethodName),
 		},
 	}, nil
@@ -295,7 +294,7 @@ type RevokeMethod struct {
 func (p *RevokeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *RevokeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *RevokeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(string)
 
@@ -314,7 +313,7 @@ func (p *RevokeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *RevokeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -337,7 +336,7 @@ func (p *RevokeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlo
 
 	momentum, err := context.GetFrontierMomentum()
 	common.DealWithErr(err)
-	if status, _ := PillarGetRevokeStatus(pillar, momentum); !status {
+	if status, _ := PillarGetRevokeStatus(context.ChainParams(), pillar, momentum); !status {
 		return nil, constants.RevokeNotDue
 	}
 
@@ -350,8 +349,8 @@ func (p *RevokeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlo
 			Address:       types.PillarContract,
 			ToAddress:     pillar.StakeAddress,
 			BlockType:     nom.BlockTypeContractSend,
-			Amount:        constants.PillarStakeAmount,
-			TokenStandard: types.ZnnTokenStandard,
+			Amount:        context.ChainParams().PillarStakeAmount,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data:          []byte{},
 		},
 	}, nil
@@ -505,13 +504,13 @@ func computePillarsRewardForEpoch(context vm_context.AccountVmContext, epoch uin
 	}
 	sort.Strings(pillarNames)
 	for _, name := range pillarNames {
-		rewardMap[name] = computePillarRewardForEpoch(detailList, name)
+		rewardMap[name] = computePillarRewardForEpoch(context.ChainParams(), detailList, name)
 	}
 	return rewardMap, nil
 }
 
 // raw reward for one pillar in one epoch
-func computePillarRewardForEpoch(detail *api.EpochStats, name string) *pillarEpochReward {
+func computePillarRewardForEpoch(params *constants.ChainParams, detail *api.EpochStats, name string) *pillarEpochReward {
 	selfDetail, ok := detail.Pillars[name]
 	reward := &pillarEpochReward{
 		DelegationReward: big.NewInt(0),
@@ -534,7 +533,7 @@ func computePillarRewardForEpoch(detail *api.EpochStats, name string) *pillarEpo
 	//	      + BlockProducingRewardsPerBlock * selfProducesBlocksNum
 
 	tmp := new(big.Int)
-	delegationRewardsPerBlock, blockProducingRewardsPerBlock := constants.PillarRewardPerMomentum(detail.Epoch)
+	delegationRewardsPerBlock, blockProducingRewardsPerBlock := params.PillarRewardPerMomentum(detail.Epoch, detail.BlockTime)
 
 	if detail.TotalWeight.Sign() != 0 {
 		reward.DelegationReward.Set(delegationRewardsPerBlock)
@@ -586,7 +585,7 @@ type UpdatePillarMethod struct {
 func (p *UpdatePillarMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdatePillarMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdatePillarMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.RegisterParam)
 
@@ -608,7 +607,7 @@ func (p *UpdatePillarMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UpdatePillarMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -671,7 +670,7 @@ type DelegateMethod struct {
 func (p *DelegateMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *DelegateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *DelegateMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(string)
 
@@ -690,7 +689,7 @@ func (p *DelegateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *DelegateMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -728,7 +727,7 @@ type UndelegateMethod struct {
 func (p *UndelegateMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UndelegateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UndelegateMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIPillars.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -743,7 +742,7 @@ func (p *UndelegateMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UndelegateMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -767,7 +766,7 @@ type UpdateEmbeddedPillarMethod struct {
 func (p *UpdateEmbeddedPillarMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdateEmbeddedPillarMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdateEmbeddedPillarMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIPillars.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -782,7 +781,7 @@ func (p *UpdateEmbeddedPillarMethod) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (p *UpdateEmbeddedPillarMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
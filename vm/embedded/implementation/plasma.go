@@ -22,7 +22,7 @@ type FuseMethod struct {
 func (p *FuseMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *FuseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *FuseMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(types.Address)
 
@@ -31,12 +31,12 @@ func (p *FuseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	}
 
 	// make sure users send QSR and more than min amount
-	if block.TokenStandard != types.QsrTokenStandard || block.Amount.Cmp(constants.FuseMinAmount) < 0 {
+	if block.TokenStandard != params.QsrTokenStandard || block.Amount.Cmp(params.FuseMinAmount) < 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
-	// make sure users send multiple of constants.CostPerFusionUnit
-	mod := new(big.Int).Mod(block.Amount, big.NewInt(constants.CostPerFusionUnit))
+	// make sure users send multiple of params.CostPerFusionUnit
+	mod := new(big.Int).Mod(block.Amount, params.CostPerFusionUnit)
 	if mod.Sign() != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
@@ -45,7 +45,7 @@ func (p *FuseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *FuseMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -61,7 +61,7 @@ func (p *FuseMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 		Id:               sendBlock.Hash,
 		Amount:           sendBlock.Amount,
 		Beneficiary:      *beneficiary,
-		ExpirationHeight: momentum.Height + constants.FuseExpiration,
+		ExpirationHeight: momentum.Height + context.ChainParams().FuseExpiration,
 	}
 	common.DealWithErr(fusionInfo.Save(context.Storage()))
 
@@ -81,7 +81,7 @@ type CancelFuseMethod struct {
 func (p *CancelFuseMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *CancelFuseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CancelFuseMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(types.Hash)
 
@@ -97,7 +97,7 @@ func (p *CancelFuseMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *CancelFuseMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +137,7 @@ func (p *CancelFuseMethod) ReceiveBlock(context vm_context.AccountVmContext, sen
 			ToAddress:     sendBlock.Address,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        fusionInfo.Amount,
-			TokenStandard: types.QsrTokenStandard,
+			TokenStandard: context.ChainParams().QsrTokenStandard,
 			Data:          []byte{},
 		},
 	}, nil
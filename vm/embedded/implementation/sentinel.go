@@ -5,8 +5,6 @@ import (
 
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
-
-	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/vm/constants"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 	"github.com/zenon-network/go-zenon/vm/vm_context"
@@ -18,12 +16,12 @@ var (
 
 // GetSentinelRevokeStatus returns true, timeWhileCanRevoke if sentinel *can* be revoked
 // GetSentinelRevokeStatus returns false, timeUntilCanRevoke if sentinel *can't* be revoked
-func GetSentinelRevokeStatus(registrationTime int64, m *nom.Momentum) (bool, int64) {
-	epochTime := (m.Timestamp.Unix() - registrationTime) % (constants.SentinelLockTimeWindow + constants.SentinelRevokeTimeWindow)
-	if epochTime < constants.SentinelLockTimeWindow {
-		return false, constants.SentinelLockTimeWindow - epochTime
+func GetSentinelRevokeStatus(params *constants.ChainParams, registrationTime int64, m *nom.Momentum) (bool, int64) {
+	epochTime := (m.Timestamp.Unix() - registrationTime) % (params.SentinelLockTimeWindow + params.SentinelRevokeTimeWindow)
+	if epochTime < params.SentinelLockTimeWindow {
+		return false, params.SentinelLockTimeWindow - epochTime
 	} else {
-		return true, (constants.SentinelLockTimeWindow + constants.SentinelRevokeTimeWindow) - epochTime
+		return true, (params.SentinelLockTimeWindow + params.SentinelRevokeTimeWindow) - epochTime
 	}
 }
 
@@ -34,14 +32,14 @@ type RegisterSentinelMethod struct {
 func (method *RegisterSentinelMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (method *RegisterSentinelMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (method *RegisterSentinelMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABISentinel.UnpackEmptyMethod(method.MethodName, block.Data); err != nil {
 		return constants.ErrUnpackError
 	}
 
-	if block.TokenStandard != types.ZnnTokenStandard || block.Amount.Cmp(constants.SentinelZnnRegisterAmount) != 0 {
+	if block.TokenStandard != params.ZnnTokenStandard || block.Amount.Cmp(params.SentinelZnnRegisterAmount) != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -49,7 +47,7 @@ func (method *RegisterSentinelMethod) ValidateSendBlock(block *nom.AccountBlock)
 	return err
 }
 func (method *RegisterSentinelMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := method.ValidateSendBlock(sendBlock); err != nil {
+	if err := method.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		sentinelLog.Debug("invalid register - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -60,7 +58,7 @@ func (method *RegisterSentinelMethod) ReceiveBlock(context vm_context.AccountVmC
 		return nil, constants.ErrAlreadyRegistered
 	}
 
-	if err := checkAndConsumeQsr(context, sendBlock.Address, constants.SentinelQsrDepositAmount); err != nil {
+	if err := checkAndConsumeQsr(context, sendBlock.Address, context.ChainParams().SentinelQsrDepositAmount); err != nil {
 		sentinelLog.Debug("invalid register - not enough deposited qsr", "address", sendBlock.Address)
 		return nil, err
 	}
@@ -74,8 +72,8 @@ func (method *RegisterSentinelMethod) ReceiveBlock(context vm_context.AccountVmC
 		},
 		RegistrationTimestamp: frontierMomentum.Timestamp.Unix(),
 		RevokeTimestamp:       0,
-		ZnnAmount:             constants.SentinelZnnRegisterAmount,
-		QsrAmount:             constants.SentinelQsrDepositAmount,
+		ZnnAmount:             context.ChainParams().SentinelZnnRegisterAmount,
+		QsrAmount:             context.ChainParams().SentinelQsrDepositAmount,
 	}
 	sentinel.Save(context.Storage())
 	sentinelLog.Debug("successfully register", "sentinel", sentinel)
@@ -89,7 +87,7 @@ type RevokeSentinelMethod struct {
 func (method *RevokeSentinelMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWDoubleWithdraw, nil
 }
-func (method *RevokeSentinelMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (method *RevokeSentinelMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABISentinel.UnpackEmptyMethod(method.MethodName, block.Data); err != nil {
@@ -104,7 +102,7 @@ func (method *RevokeSentinelMethod) ValidateSendBlock(block *nom.AccountBlock) e
 	return err
 }
 func (method *RevokeSentinelMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := method.ValidateSendBlock(sendBlock); err != nil {
+	if err := method.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		sentinelLog.Debug("invalid revoke - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -123,7 +121,7 @@ func (method *RevokeSentinelMethod) ReceiveBlock(context vm_context.AccountVmCon
 		return nil, constants.ErrAlreadyRevoked
 	}
 
-	if canRevoke, untilRevoke := GetSentinelRevokeStatus(sentinel.RegistrationTimestamp, frontierMomentum); !canRevoke {
+	if canRevoke, untilRevoke := GetSentinelRevokeStatus(context.ChainParams(), sentinel.RegistrationTimestamp, frontierMomentum); !canRevoke {
 		sentinelLog.Debug("invalid revoke - cannot be revoked yet", "address", sendBlock.Address, "until-revoke", untilRevoke)
 		return nil, constants.RevokeNotDue
 	}
@@ -140,12 +138,12 @@ func (method *RevokeSentinelMethod) ReceiveBlock(context vm_context.AccountVmCon
 		{
 			ToAddress:     sentinel.Owner,
 			Amount:        znnAmount,
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 		},
 		{
 			ToAddress:     sentinel.Owner,
 			Amount:        qsrAmount,
-			TokenStandard: types.QsrTokenStandard,
+			TokenStandard: context.ChainParams().QsrTokenStandard,
 		},
 	}, nil
 }
@@ -157,7 +155,7 @@ type UpdateEmbeddedSentinelMethod struct {
 func (method *UpdateEmbeddedSentinelMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (method *UpdateEmbeddedSentinelMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (method *UpdateEmbeddedSentinelMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABISentinel.UnpackEmptyMethod(method.MethodName, block.Data); err != nil {
@@ -172,7 +170,7 @@ func (method *UpdateEmbeddedSentinelMethod) ValidateSendBlock(block *nom.Account
 	return err
 }
 func (method *UpdateEmbeddedSentinelMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := method.ValidateSendBlock(sendBlock); err != nil {
+	if err := method.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		sentinelLog.Debug("invalid update - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -207,7 +205,7 @@ func computeSentinelRewardsForEpoch(context vm_context.AccountVmContext, epoch u
 	startTime, endTime := context.EpochTicker().ToTime(epoch)
 
 	cumulatedSentinel := big.NewInt(0)
-	totalZnnAmount, totalQsrAmount := constants.SentinelRewardForEpoch(epoch)
+	totalZnnAmount, totalQsrAmount := context.ChainParams().SentinelRewardForEpoch(epoch)
 
 	err := definition.IterateSentinelEntries(context.Storage(), func(sentinelInfo *definition.SentinelInfo) error {
 		cumulatedSentinel.Add(cumulatedSentinel, getWeightedSentinel(sentinelInfo, startTime.Unix(), endTime.Unix()))
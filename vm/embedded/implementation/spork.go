@@ -30,8 +30,8 @@ func checkSporkMetaDataStatic(sporkInfo *definition.Spork) error {
 func (p *CreateSporkMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *CreateSporkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
-	if block.Address != *types.SporkAddress {
+func (p *CreateSporkMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	if block.Address != *params.SporkAddress {
 		return constants.ErrPermissionDenied
 	}
 	if block.Amount.Sign() != 0 {
@@ -58,7 +58,7 @@ func (p *CreateSporkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return nil
 }
 func (p *CreateSporkMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		sporkLog.Debug("invalid create - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -91,10 +91,10 @@ type ActivateSporkMethod struct {
 func (p *ActivateSporkMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *ActivateSporkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *ActivateSporkMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
-	if block.Address != *types.SporkAddress {
+	if block.Address != *params.SporkAddress {
 		return constants.ErrPermissionDenied
 	}
 	id := new(types.Hash)
@@ -110,7 +110,7 @@ func (p *ActivateSporkMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *ActivateSporkMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		sporkLog.Debug("invalid spork activation - syntactic validation failed", "address", sendBlock.Address, "reason", err)
 		return nil, err
 	}
@@ -138,3 +138,75 @@ func (p *ActivateSporkMethod) ReceiveBlock(context vm_context.AccountVmContext,
 	sporkLog.Debug("activated", "spork", spork)
 	return nil, nil
 }
+
+type CreateConsensusSporkMethod struct {
+	MethodName string
+}
+
+func checkSporkConsensusParametersStatic(params *constants.ChainParams, param *definition.CreateConsensusSporkParam) error {
+	if param.NodeCount == 0 {
+		return constants.ErrForbiddenParam
+	}
+	if param.RandCount > param.NodeCount {
+		return constants.ErrForbiddenParam
+	}
+	// the election ticks must divide the epoch, the consensus ignores the spork otherwise
+	if constants.SecsInDay%(params.Consensus.BlockTime*int64(param.NodeCount)) != 0 {
+		return constants.ErrForbiddenParam
+	}
+	return nil
+}
+
+func (p *CreateConsensusSporkMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
+	return plasmaTable.EmbeddedSimple, nil
+}
+func (p *CreateConsensusSporkMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
+	var err error
+
+	if block.Address != *params.SporkAddress {
+		return constants.ErrPermissionDenied
+	}
+	if block.Amount.Sign() != 0 {
+		return constants.ErrInvalidTokenOrAmount
+	}
+	param := new(definition.CreateConsensusSporkParam)
+	if err := definition.ABISpork.UnpackMethod(param, p.MethodName, block.Data); err != nil {
+		return constants.ErrUnpackError
+	}
+
+	if err := checkSporkMetaDataStatic(&definition.Spork{Name: param.Name, Description: param.Description}); err != nil {
+		return err
+	}
+	if err := checkSporkConsensusParametersStatic(params, param); err != nil {
+		return err
+	}
+
+	block.Data, err = definition.ABISpork.PackMethod(p.MethodName, param.Name, param.Description, param.NodeCount, param.RandCount)
+	return err
+}
+func (p *CreateConsensusSporkMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
+		sporkLog.Debug("invalid create consensus - syntactic validation failed", "address", sendBlock.Address, "reason", err)
+		return nil, err
+	}
+
+	param := new(definition.CreateConsensusSporkParam)
+	err := definition.ABISpork.UnpackMethod(param, p.MethodName, sendBlock.Data)
+	common.DealWithErr(err)
+
+	spork := &definition.Spork{
+		Id:          sendBlock.Hash,
+		Name:        param.Name,
+		Description: param.Description,
+	}
+	spork.Save(context.Storage())
+	parameters := &definition.SporkConsensusParameters{
+		Id:        sendBlock.Hash,
+		NodeCount: param.NodeCount,
+		RandCount: param.RandCount,
+	}
+	parameters.Save(context.Storage())
+
+	sporkLog.Debug("created", "spork", spork, "consensus-parameters", parameters)
+	return nil, nil
+}
@@ -19,8 +19,8 @@ type StakeMethod struct {
 	MethodName string
 }
 
-func getWeightedStakeAmount(amount *big.Int, stakingTime int64) *big.Int {
-	weighted := big.NewInt(9 + stakingTime/constants.StakeTimeUnitSec)
+func getWeightedStakeAmount(params *constants.ChainParams, amount *big.Int, stakingTime int64) *big.Int {
+	weighted := big.NewInt(9 + stakingTime/params.StakeTimeUnitSec)
 	weighted.Mul(weighted, amount)
 	weighted.Div(weighted, big.NewInt(10))
 	return weighted
@@ -29,7 +29,7 @@ func getWeightedStakeAmount(amount *big.Int, stakingTime int64) *big.Int {
 func (p *StakeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *StakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *StakeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	var stakeTime int64
 
@@ -37,10 +37,10 @@ func (p *StakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return constants.ErrUnpackError
 	}
 
-	if block.Amount.Cmp(constants.StakeMinAmount) == -1 || block.TokenStandard != types.ZnnTokenStandard {
+	if block.Amount.Cmp(params.StakeMinAmount) == -1 || block.TokenStandard != params.ZnnTokenStandard {
 		return constants.ErrInvalidTokenOrAmount
 	}
-	if stakeTime < constants.StakeTimeMinSec || stakeTime > constants.StakeTimeMaxSec || stakeTime%constants.StakeTimeUnitSec != 0 {
+	if stakeTime < params.StakeTimeMinSec || stakeTime > params.StakeTimeMaxSec || stakeTime%params.StakeTimeUnitSec != 0 {
 		return constants.ErrInvalidStakingPeriod
 	}
 
@@ -48,7 +48,7 @@ func (p *StakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *StakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -60,7 +60,7 @@ func (p *StakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBloc
 
 	stakeInfo := definition.StakeInfo{
 		Amount:         sendBlock.Amount,
-		WeightedAmount: getWeightedStakeAmount(sendBlock.Amount, stakeTime),
+		WeightedAmount: getWeightedStakeAmount(context.ChainParams(), sendBlock.Amount, stakeTime),
 		StartTime:      momentum.Timestamp.Unix(),
 		RevokeTime:     0,
 		ExpirationTime: momentum.Timestamp.Unix() + stakeTime,
@@ -80,7 +80,7 @@ type CancelStakeMethod struct {
 func (p *CancelStakeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *CancelStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *CancelStakeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	id := new(types.Hash)
 
@@ -96,7 +96,7 @@ func (p *CancelStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *CancelStakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -131,7 +131,7 @@ func (p *CancelStakeMethod) ReceiveBlock(context vm_context.AccountVmContext, se
 			ToAddress:     stakeInfo.StakeAddress,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        amount,
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data:          nil,
 		},
 	}, nil
@@ -144,7 +144,7 @@ type UpdateEmbeddedStakeMethod struct {
 func (p *UpdateEmbeddedStakeMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdateEmbeddedStakeMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdateEmbeddedStakeMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIStake.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -159,7 +159,7 @@ func (p *UpdateEmbeddedStakeMethod) ValidateSendBlock(block *nom.AccountBlock) e
 	return err
 }
 func (p *UpdateEmbeddedStakeMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -192,7 +192,7 @@ func computeStakeRewardsForEpoch(context vm_context.AccountVmContext, epoch uint
 	startTime, endTime := context.EpochTicker().ToTime(epoch)
 
 	cumulatedStake := big.NewInt(0)
-	totalAmount := constants.StakeQsrRewardPerEpoch(epoch)
+	totalAmount := context.ChainParams().StakeQsrRewardPerEpoch(epoch)
 
 	err := definition.IterateStakeEntries(context.Storage(), func(stakeInfo *definition.StakeInfo) error {
 		cumulatedStake.Add(cumulatedStake, getWeightedStake(stakeInfo, startTime.Unix(), endTime.Unix()))
@@ -202,7 +202,7 @@ func computeStakeRewardsForEpoch(context vm_context.AccountVmContext, epoch uint
 		return err
 	}
 
-	stakeLog.Debug("updating stake reward", "epoch", epoch, "total-reward", constants.StakeQsrRewardPerEpoch(epoch), "cumulated-stake", cumulatedStake, "start-time", startTime.Unix(), "end-time", endTime.Unix())
+	stakeLog.Debug("updating stake reward", "epoch", epoch, "total-reward", context.ChainParams().StakeQsrRewardPerEpoch(epoch), "cumulated-stake", cumulatedStake, "start-time", startTime.Unix(), "end-time", endTime.Unix())
 	if cumulatedStake.Sign() == 0 {
 		return nil
 	}
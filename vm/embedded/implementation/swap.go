@@ -43,7 +43,7 @@ type SwapRetrieveAssetsMethod struct {
 func (p *SwapRetrieveAssetsMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWDoubleWithdraw, nil
 }
-func (p *SwapRetrieveAssetsMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *SwapRetrieveAssetsMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.ParamRetrieveAssets)
 
@@ -62,7 +62,7 @@ func (p *SwapRetrieveAssetsMethod) ValidateSendBlock(block *nom.AccountBlock) er
 	return err
 }
 func (p *SwapRetrieveAssetsMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -99,10 +99,10 @@ func (p *SwapRetrieveAssetsMethod) ReceiveBlock(context vm_context.AccountVmCont
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        big.NewInt(0),
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.ZnnTokenStandard,
+				context.ChainParams().ZnnTokenStandard,
 				deposit.Znn,
 				sendBlock.Address,
 			),
@@ -114,10 +114,10 @@ func (p *SwapRetrieveAssetsMethod) ReceiveBlock(context vm_context.AccountVmCont
 			ToAddress:     types.TokenContract,
 			BlockType:     nom.BlockTypeContractSend,
 			Amount:        big.NewInt(0),
-			TokenStandard: types.ZnnTokenStandard,
+			TokenStandard: context.ChainParams().ZnnTokenStandard,
 			Data: definition.ABIToken.PackMethodPanic(
 				definition.MintMethodName,
-				types.QsrTokenStandard,
+				context.ChainParams().QsrTokenStandard,
 				deposit.Qsr,
 				sendBlock.Address,
 			),
@@ -74,7 +74,7 @@ func newTokenID(sendBlockHash types.Hash) types.ZenonTokenStandard {
 func (p *IssueMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *IssueMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *IssueMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.IssueParam)
 
@@ -85,10 +85,10 @@ func (p *IssueMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 		return err
 	}
 
-	if block.TokenStandard != types.ZnnTokenStandard {
+	if block.TokenStandard != params.ZnnTokenStandard {
 		return constants.ErrInvalidTokenOrAmount
 	}
-	if block.Amount.Cmp(constants.TokenIssueAmount) != 0 {
+	if block.Amount.Cmp(params.TokenIssueAmount) != 0 {
 		return constants.ErrInvalidTokenOrAmount
 	}
 
@@ -106,7 +106,7 @@ func (p *IssueMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *IssueMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -160,7 +160,7 @@ type MintMethod struct {
 func (p *MintMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedWWithdraw, nil
 }
-func (p *MintMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *MintMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.MintParam)
 	if err := definition.ABIToken.UnpackMethod(param, p.MethodName, block.Data); err != nil {
@@ -177,7 +177,7 @@ func (p *MintMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *MintMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -199,11 +199,11 @@ func (p *MintMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock
 	}
 
 	// check owner, all embedded contracts for ZNN and QSR
-	if param.TokenStandard == types.ZnnTokenStandard {
+	if param.TokenStandard == context.ChainParams().ZnnTokenStandard {
 		if !types.IsEmbeddedAddress(sendBlock.Address) {
 			return nil, constants.ErrPermissionDenied
 		}
-	} else if param.TokenStandard == types.QsrTokenStandard {
+	} else if param.TokenStandard == context.ChainParams().QsrTokenStandard {
 		if !types.IsEmbeddedAddress(sendBlock.Address) {
 			return nil, constants.ErrPermissionDenied
 		}
@@ -243,7 +243,7 @@ type BurnMethod struct {
 func (p *BurnMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *BurnMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *BurnMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 
 	if err := definition.ABIToken.UnpackEmptyMethod(p.MethodName, block.Data); err != nil {
@@ -258,7 +258,7 @@ func (p *BurnMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *BurnMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
@@ -292,7 +292,7 @@ type UpdateTokenMethod struct {
 func (p *UpdateTokenMethod) GetPlasma(plasmaTable *constants.PlasmaTable) (uint64, error) {
 	return plasmaTable.EmbeddedSimple, nil
 }
-func (p *UpdateTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
+func (p *UpdateTokenMethod) ValidateSendBlock(params *constants.ChainParams, block *nom.AccountBlock) error {
 	var err error
 	param := new(definition.UpdateTokenParam)
 
@@ -308,7 +308,7 @@ func (p *UpdateTokenMethod) ValidateSendBlock(block *nom.AccountBlock) error {
 	return err
 }
 func (p *UpdateTokenMethod) ReceiveBlock(context vm_context.AccountVmContext, sendBlock *nom.AccountBlock) ([]*nom.AccountBlock, error) {
-	if err := p.ValidateSendBlock(sendBlock); err != nil {
+	if err := p.ValidateSendBlock(context.ChainParams(), sendBlock); err != nil {
 		return nil, err
 	}
 
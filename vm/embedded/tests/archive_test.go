@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain/archive"
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/protocol"
+	"github.com/zenon-network/go-zenon/vm"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+func newArchiveSource(t *testing.T) mock.MockZenon {
+	z := mock.NewMockZenon(t)
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	autoreceive(t, z, g.User2.Address)
+	z.InsertMomentumsTo(30)
+	return z
+}
+
+func importArchive(z mock.MockZenon, data []byte) (*archive.Progress, error) {
+	bridge := protocol.NewChainBridge(z.Chain(), z.Consensus(), z.Verifier(), vm.NewSupervisor(z.Chain(), z.Consensus()))
+	return archive.Import(z.Chain(), bytes.NewReader(data), bridge.InsertChain, nil)
+}
+
+// - export the momentums of a chain and import them in a new node
+// - importing again skips the momentums which are already inserted
+func TestArchive_ExportImport(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+	buf := new(bytes.Buffer)
+	common.FailIfErr(t, archive.Export(source.Chain(), 2, 30, buf))
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	progress, err := importArchive(z, buf.Bytes())
+	common.Json(progress, err).Equals(t, `
+{
+	"height": 30,
+	"toHeight": 30,
+	"inserted": 29,
+	"skipped": 0
+}`)
+	common.ExpectString(t, z.Chain().GetFrontierMomentumStore().Identifier().Hash.String(), source.Chain().GetFrontierMomentumStore().Identifier().Hash.String())
+	z.ExpectBalance(g.User2.Address, types.ZnnTokenStandard, 8010*g.Zexp)
+
+	progress, err = importArchive(z, buf.Bytes())
+	common.Json(progress, err).Equals(t, `
+{
+	"height": 30,
+	"toHeight": 30,
+	"inserted": 0,
+	"skipped": 29
+}`)
+}
+
+// - an interrupted import resumes from the local frontier
+// - a truncated archive is reported as invalid after inserting the complete momentums
+func TestArchive_Resume(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+	buf := new(bytes.Buffer)
+	common.FailIfErr(t, archive.Export(source.Chain(), 2, 30, buf))
+	partial := new(bytes.Buffer)
+	common.FailIfErr(t, archive.Export(source.Chain(), 2, 12, partial))
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	_, err := importArchive(z, buf.Bytes()[:buf.Len()-10])
+	common.ExpectError(t, errors.Cause(err), archive.ErrInvalidArchive)
+	progress, err := importArchive(z, partial.Bytes())
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, progress.Skipped, 11)
+
+	progress, err = importArchive(z, buf.Bytes())
+	common.Json(progress, err).Equals(t, `
+{
+	"height": 30,
+	"toHeight": 30,
+	"inserted": 1,
+	"skipped": 28
+}`)
+}
+
+// - archives of other chains or which don't link to the local frontier are rejected
+func TestArchive_Rejected(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+	buf := new(bytes.Buffer)
+	common.FailIfErr(t, archive.Export(source.Chain(), 20, 30, buf))
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	_, err := importArchive(z, buf.Bytes())
+	common.ExpectError(t, errors.Cause(err), archive.ErrArchiveDoesNotLink)
+
+	_, err = importArchive(z, []byte("not an archive"))
+	common.ExpectError(t, errors.Cause(err), archive.ErrInvalidArchive)
+}
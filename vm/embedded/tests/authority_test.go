@@ -0,0 +1,290 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain/genesis"
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/rpc/api/embedded"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// newAuthorityGenesis returns the mock genesis in POA mode with 2 authorities managed by User1 and User2
+func newAuthorityGenesis(t *testing.T) *genesis.GenesisConfig {
+	config := *g.EmbeddedGenesis
+	config.HyperQube = &genesis.HyperQubeConfig{
+		ZnnTokenStandard:  types.ZnnTokenStandard,
+		QsrTokenStandard:  types.QsrTokenStandard,
+		ElectionAlgorithm: constants.MAINNET.String(),
+		BlockTime:         10,
+		ConsensusMode:     constants.ProofOfAuthority.String(),
+	}
+	config.AuthorityConfig = &genesis.AuthorityContractConfig{
+		Authorities: []*definition.AuthorityInfo{
+			{Name: "authority-1", ProducerAddress: g.Pillar4.Address},
+			{Name: "authority-2", ProducerAddress: g.Pillar5.Address},
+		},
+		Admins:    []types.Address{g.User1.Address, g.User2.Address},
+		Threshold: 2,
+	}
+	common.FailIfErr(t, genesis.CheckGenesis(&config))
+	return &config
+}
+
+// - the genesis authorities produce the momentums instead of the pillars
+// - an authority is added once the threshold of the admins sent the same call
+// - the elections which follow use the new authority
+func TestAuthority_AddAuthority(t *testing.T) {
+	z := mock.NewMockZenonWithCustomGenesis(t, newAuthorityGenesis(t))
+	defer z.StopPanic()
+	authorityApi := embedded.NewAuthorityApi(z)
+	consensusApi := api.NewConsensusApi(z)
+
+	z.InsertMomentumsTo(10)
+	common.Json(authorityApi.GetAll(0, 10)).Equals(t, `
+{
+	"count": 2,
+	"list": [
+		{
+			"name": "authority-1",
+			"producerAddress": "z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg",
+			"registrationTime": 1000000000
+		},
+		{
+			"name": "authority-2",
+			"producerAddress": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+			"registrationTime": 1000000000
+		}
+	]
+}`)
+	common.Json(authorityApi.GetAdmins()).Equals(t, `
+{
+	"admins": [
+		"z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz",
+		"z1qr4pexnnfaexqqz8nscjjcsajy5hdqfkgadvwx"
+	],
+	"threshold": 2
+}`)
+	current, err := consensusApi.GetCurrentElection()
+	common.FailIfErr(t, err)
+	common.Json(current.Delegations, nil).Equals(t, `
+[
+	{
+		"name": "authority-1",
+		"producer": "z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg",
+		"weight": 1
+	},
+	{
+		"name": "authority-2",
+		"producer": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+		"weight": 1
+	}
+]`)
+
+	for _, admin := range []types.Address{g.User1.Address, g.User2.Address} {
+		common.Json(authorityApi.GetAll(0, 10)).Equals(t, `
+{
+	"count": 2,
+	"list": [
+		{
+			"name": "authority-1",
+			"producerAddress": "z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg",
+			"registrationTime": 1000000000
+		},
+		{
+			"name": "authority-2",
+			"producerAddress": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+			"registrationTime": 1000000000
+		}
+	]
+}`)
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:   admin,
+			ToAddress: types.AuthorityContract,
+			Data: definition.ABIAuthority.PackMethodPanic(definition.AddAuthorityMethodName,
+				"authority-3",     // name
+				g.Pillar6.Address, // producer address
+			),
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+		z.InsertNewMomentum()
+	}
+	common.Json(authorityApi.GetAll(0, 10)).Equals(t, `
+{
+	"count": 3,
+	"list": [
+		{
+			"name": "authority-1",
+			"producerAddress": "z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg",
+			"registrationTime": 1000000000
+		},
+		{
+			"name": "authority-2",
+			"producerAddress": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+			"registrationTime": 1000000000
+		},
+		{
+			"name": "authority-3",
+			"producerAddress": "z1qqgrqcklnx08k8qwxvrryj9f92gngmcm7ltgms",
+			"registrationTime": 1000000120
+		}
+	]
+}`)
+
+	z.InsertMomentumsTo(90)
+	current, err = consensusApi.GetCurrentElection()
+	common.FailIfErr(t, err)
+	common.Json(current.Delegations, nil).Equals(t, `
+[
+	{
+		"name": "authority-1",
+		"producer": "z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg",
+		"weight": 1
+	},
+	{
+		"name": "authority-2",
+		"producer": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+		"weight": 1
+	},
+	{
+		"name": "authority-3",
+		"producer": "z1qqgrqcklnx08k8qwxvrryj9f92gngmcm7ltgms",
+		"weight": 1
+	}
+]`)
+	frontier, err := z.Chain().GetFrontierMomentumStore().GetFrontierMomentum()
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, frontier.Producer() == g.Pillar4.Address || frontier.Producer() == g.Pillar5.Address || frontier.Producer() == g.Pillar6.Address)
+}
+
+// - send blocks from non admins are rejected by the contract, before the other checks
+// - the last authority can't be removed
+func TestAuthority_Permissions(t *testing.T) {
+	z := mock.NewMockZenonWithCustomGenesis(t, newAuthorityGenesis(t))
+	defer z.StopPanic()
+	authorityApi := embedded.NewAuthorityApi(z)
+	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
+t=2001-09-09T01:47:30+0000 lvl=dbug msg="voted proposal" module=embedded contract=authority id=beb1d67974fd7e405d51bd22685d941e1f1cfcd3444014b50571358202149c33 admin=z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz votes=1 threshold=2
+t=2001-09-09T01:47:50+0000 lvl=dbug msg="removed authority" module=embedded contract=authority authority="&{Name:authority-1 ProducerAddress:z1qplpsv3wcm64js30jlumxlatgxxkqr6hgv30fg RegistrationTime:1000000000}"
+`)
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.User3.Address,
+		ToAddress: types.AuthorityContract,
+		Data:      definition.ABIAuthority.PackMethodPanic(definition.RemoveAuthorityMethodName, "authority-1"),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+	defer z.CallContract(&nom.AccountBlock{
+		Address:   g.User3.Address,
+		ToAddress: types.AuthorityContract,
+		Data:      definition.ABIAuthority.PackMethodPanic(definition.AddAuthorityMethodName, "authority-1", g.Pillar6.Address),
+	}).Error(t, constants.ErrNotAuthorityAdmin)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+
+	for _, admin := range []types.Address{g.User1.Address, g.User2.Address} {
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:   admin,
+			ToAddress: types.AuthorityContract,
+			Data:      definition.ABIAuthority.PackMethodPanic(definition.RemoveAuthorityMethodName, "authority-1"),
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+		z.InsertNewMomentum()
+	}
+	for _, admin := range []types.Address{g.User1.Address, g.User2.Address} {
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:   admin,
+			ToAddress: types.AuthorityContract,
+			Data:      definition.ABIAuthority.PackMethodPanic(definition.RemoveAuthorityMethodName, "authority-2"),
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+		z.InsertNewMomentum()
+	}
+	common.Json(authorityApi.GetAll(0, 10)).Equals(t, `
+{
+	"count": 1,
+	"list": [
+		{
+			"name": "authority-2",
+			"producerAddress": "z1qzv6ch3znujldgkq3krlzq38hu5n2pqg3xsjgv",
+			"registrationTime": 1000000000
+		}
+	]
+}`)
+}
+
+// - the votes of a proposal are dropped once it expires
+// - the admin which votes after the expiration starts the proposal over
+func TestAuthority_ProposalExpiration(t *testing.T) {
+	config := newAuthorityGenesis(t)
+	// 48 momentums per epoch, the proposals expire after 336 momentums
+	config.HyperQube.BlockTime = 1440
+	// the mocks with a custom epoch duration don't restore it, so it can be shorter than the election tick
+	defer func(epochDuration time.Duration) { consensus.EpochDuration = epochDuration }(consensus.EpochDuration)
+	consensus.EpochDuration = 24 * time.Hour
+	z := mock.NewMockZenonWithCustomGenesis(t, config)
+	defer z.StopPanic()
+	authorityApi := embedded.NewAuthorityApi(z)
+
+	data := definition.ABIAuthority.PackMethodPanic(definition.AddAuthorityMethodName, "authority-3", g.Pillar6.Address)
+	id := types.NewHash(data)
+	vote := func(admin types.Address) {
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:   admin,
+			ToAddress: types.AuthorityContract,
+			Data:      data,
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+		z.InsertNewMomentum()
+	}
+
+	vote(g.User1.Address)
+	common.Json(authorityApi.GetProposal(id)).Equals(t, `
+{
+	"id": "`+id.String()+`",
+	"votes": [
+		"z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz"
+	],
+	"expirationHeight": 338
+}`)
+
+	z.InsertMomentumsTo(338)
+	vote(g.User2.Address)
+	common.Json(authorityApi.GetProposal(id)).Equals(t, `
+{
+	"id": "`+id.String()+`",
+	"votes": [
+		"z1qr4pexnnfaexqqz8nscjjcsajy5hdqfkgadvwx"
+	],
+	"expirationHeight": 675
+}`)
+	authorities, err := authorityApi.GetAll(0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(authorities.Count), 2)
+
+	vote(g.User1.Address)
+	authorities, err = authorityApi.GetAll(0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(authorities.Count), 3)
+}
+
+// The authority contract doesn't exist on proof of stake chains
+func TestAuthority_DisabledOnProofOfStake(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.User1.Address,
+		ToAddress: types.AuthorityContract,
+		Data:      definition.ABIAuthority.PackMethodPanic(definition.RemoveAuthorityMethodName, "authority-1"),
+	}, constants.ErrContractDoesntExist, mock.NoVmChanges)
+}
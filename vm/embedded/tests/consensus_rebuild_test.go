@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/consensus/storage"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// - the rebuilt consensus DB matches the chain
+// - verify reports the first stored point which differs from the recomputed one
+// - rebuild fixes the consensus DB
+func TestConsensusDB_RebuildAndVerify(t *testing.T) {
+	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	defer z.StopPanic()
+	z.InsertMomentumsTo(800)
+
+	consensusDB := db.NewMemDB()
+	result, err := consensus.RebuildDB(consensusDB, z.Chain())
+	common.FailIfErr(t, err)
+	common.Json(result, nil).Equals(t, `
+{
+	"elections": 26,
+	"periodPoints": 26,
+	"epochPoints": 2
+}`)
+	divergence, err := consensus.VerifyDB(consensusDB, db.NewMemDB(), z.Chain())
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, divergence == nil)
+
+	stored := storage.NewConsensusDB(consensusDB, 1, 1)
+	for _, tick := range []uint64{20, 5} {
+		point, err := stored.GetPointByHeight(storage.PrefixPeriodPoint, tick)
+		common.FailIfErr(t, err)
+		point.TotalWeight = new(big.Int).Add(point.TotalWeight, big.NewInt(1))
+		common.FailIfErr(t, stored.StorePointByHeight(storage.PrefixPeriodPoint, tick, point))
+	}
+	divergence, err = consensus.VerifyDB(consensusDB, db.NewMemDB(), z.Chain())
+	common.FailIfErr(t, err)
+	common.ExpectString(t, divergence.Kind, consensus.DivergencePeriodPoint)
+	common.ExpectUint64(t, divergence.Tick, 5)
+
+	_, err = consensus.RebuildDB(consensusDB, z.Chain())
+	common.FailIfErr(t, err)
+	divergence, err = consensus.VerifyDB(consensusDB, db.NewMemDB(), z.Chain())
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, divergence == nil)
+}
+
+// A missing election result is reported as a divergence without a stored value
+func TestConsensusDB_VerifyMissingElection(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	z.InsertMomentumsTo(100)
+
+	divergence, err := consensus.VerifyDB(db.NewMemDB(), db.NewMemDB(), z.Chain())
+	common.FailIfErr(t, err)
+	common.ExpectString(t, divergence.Kind, consensus.DivergenceElection)
+	common.ExpectUint64(t, divergence.Tick, 0)
+	common.ExpectString(t, divergence.Stored, "")
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// activeConsensusSpork activates the consensus parameters spork since the genesis momentum
+func activeConsensusSpork(params *constants.ChainParams) {
+	params.ActiveSporks = map[types.Hash]bool{types.ConsensusParametersSpork.SporkId: true}
+}
+
+// createConsensusSpork creates and activates a consensus spork, returns its id
+func createConsensusSpork(z mock.MockZenon, nodeCount, randCount uint8) types.Hash {
+	block := z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data: definition.ABISpork.PackMethodPanic(definition.SporkCreateConsensusMethodName,
+			"consensus-spork", // name
+			"description",     // description
+			nodeCount,
+			randCount,
+		),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data:      definition.ABISpork.PackMethodPanic(definition.SporkActivateMethodName, block.Hash),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+	return block.Hash
+}
+
+// - the parameters don't change before the first epoch after the enforcement height
+// - momentums are produced by the new committee size afterwards
+// - epoch stats carry the block time used to compute the rewards
+func TestConsensusSpork_ChangeParameters(t *testing.T) {
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, activeConsensusSpork)
+	defer z.StopPanic()
+	consensusApi := api.NewConsensusApi(z)
+
+	createConsensusSpork(z, 20, 10)
+	z.InsertMomentumsTo(20)
+	common.Json(consensusApi.GetParameters()).Equals(t, `
+[
+	{
+		"startTick": 0,
+		"startTime": 1000000000,
+		"blockTime": 10,
+		"nodeCount": 30,
+		"randCount": 15,
+		"sporks": []
+	},
+	{
+		"startTick": 12,
+		"startTime": 1000003600,
+		"blockTime": 10,
+		"nodeCount": 20,
+		"randCount": 10,
+		"sporks": [
+			"f581f6b5427f2dbe1e8989305b9bd6e174b3000f30b0e457548884b624b83d9b"
+		]
+	}
+]`)
+
+	// last momentum of the first epoch
+	z.InsertMomentumsTo(360)
+	current, err := consensusApi.GetCurrentElection()
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(current.Producers)), 30)
+
+	z.InsertNewMomentum()
+	frontier, err := z.Chain().GetFrontierMomentumStore().GetFrontierMomentum()
+	common.FailIfErr(t, err)
+	common.ExpectString(t, frontier.Timestamp.UTC().Format(time.RFC3339), "2001-09-09T02:46:40Z")
+	z.InsertNewMomentum()
+	frontier, err = z.Chain().GetFrontierMomentumStore().GetFrontierMomentum()
+	common.FailIfErr(t, err)
+	common.ExpectString(t, frontier.Timestamp.UTC().Format(time.RFC3339), "2001-09-09T02:46:50Z")
+
+	current, err = consensusApi.GetCurrentElection()
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(current.Producers)), 20)
+	common.Json(current.StartTime, nil).Equals(t, `1000003600`)
+	common.Json(current.EndTime, nil).Equals(t, `1000003800`)
+
+	reader := z.Consensus().FrontierPillarReader()
+	stats, err := reader.EpochStats(0)
+	common.FailIfErr(t, err)
+	common.Json(stats.BlockTime, nil).Equals(t, `10`)
+	stats, err = reader.EpochStats(1)
+	common.FailIfErr(t, err)
+	common.Json(stats.BlockTime, nil).Equals(t, `10`)
+}
+
+// The reward of an epoch doesn't depend on its block time
+func TestConsensusSpork_RewardPerMomentum(t *testing.T) {
+	params := constants.DefaultChainParams()
+	delegation, producing := params.PillarRewardPerMomentum(0, params.Consensus.BlockTime)
+	common.Json(delegation, nil).Equals(t, `
+40000000`)
+	common.Json(producing, nil).Equals(t, `
+83333333`)
+	delegation, producing = params.PillarRewardPerMomentum(0, params.Consensus.BlockTime/2)
+	common.Json(delegation, nil).Equals(t, `
+20000000`)
+	common.Json(producing, nil).Equals(t, `
+41666666`)
+}
+
+// - only the spork address can create consensus sporks
+// - the method doesn't exist before the consensus parameters spork is enforced
+// - the parameters are checked
+func TestConsensusSpork_InvalidCreate(t *testing.T) {
+	data := definition.ABISpork.PackMethodPanic(definition.SporkCreateConsensusMethodName, "consensus-spork", "", uint8(20), uint8(10))
+	inactive := mock.NewMockZenon(t)
+	inactive.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data:      data,
+	}, constants.ErrContractMethodNotFound, mock.NoVmChanges)
+	inactive.StopPanic()
+
+	z := mock.NewMockZenonWithChainParams(t, consensus.EpochDuration, activeConsensusSpork)
+	defer z.StopPanic()
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.User1.Address,
+		ToAddress: types.SporkContract,
+		Data:      data,
+	}, constants.ErrPermissionDenied, mock.NoVmChanges)
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data:      definition.ABISpork.PackMethodPanic(definition.SporkCreateConsensusMethodName, "consensus-spork", "", uint8(10), uint8(11)),
+	}, constants.ErrForbiddenParam, mock.NoVmChanges)
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data:      definition.ABISpork.PackMethodPanic(definition.SporkCreateConsensusMethodName, "consensus-spork", "", uint8(0), uint8(0)),
+	}, constants.ErrForbiddenParam, mock.NoVmChanges)
+	// elections of 7 momentums last 70 seconds, which don't divide an epoch
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.Spork.Address,
+		ToAddress: types.SporkContract,
+		Data:      definition.ABISpork.PackMethodPanic(definition.SporkCreateConsensusMethodName, "consensus-spork", "", uint8(7), uint8(3)),
+	}, constants.ErrForbiddenParam, mock.NoVmChanges)
+}
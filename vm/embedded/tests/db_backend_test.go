@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+var dbBackends = []string{db.LevelDBBackend, db.PebbleBackend}
+
+func insertTransfers(z mock.MockZenon, count int) {
+	for i := 0; i < count; i += 1 {
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:       g.User1.Address,
+			ToAddress:     g.User2.Address,
+			TokenStandard: types.ZnnTokenStandard,
+			Amount:        big.NewInt(1),
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+	}
+}
+
+// - the chain stored with pebble has the same momentums and state as the leveldb one
+func TestDBBackend_Pebble(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+
+	z := mock.NewMockZenonWithDBBackend(t, db.PebbleBackend)
+	defer z.StopPanic()
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	autoreceive(t, z, g.User2.Address)
+	z.InsertMomentumsTo(30)
+
+	common.ExpectString(t, z.Chain().GetFrontierMomentumStore().Identifier().Hash.String(), source.Chain().GetFrontierMomentumStore().Identifier().Hash.String())
+	z.ExpectBalance(g.User2.Address, types.ZnnTokenStandard, 8010*g.Zexp)
+	frontier, err := z.Chain().GetFrontierAccountStore(g.User2.Address).Frontier()
+	common.FailIfErr(t, err)
+	blocks, err := z.Chain().GetFrontierMomentumStore().GetAccountBlocksByHeight(g.User2.Address, 1, frontier.Height)
+	common.FailIfErr(t, err)
+	expected, err := source.Chain().GetFrontierMomentumStore().GetAccountBlocksByHeight(g.User2.Address, 1, frontier.Height)
+	common.FailIfErr(t, err)
+	for i := range blocks {
+		common.ExpectString(t, blocks[i].Hash.String(), expected[i].Hash.String())
+	}
+}
+
+func BenchmarkDBBackend_MomentumInsertion(b *testing.B) {
+	for _, backend := range dbBackends {
+		b.Run(backend, func(b *testing.B) {
+			z := mock.NewMockZenonWithDBBackend(b, backend)
+			defer z.StopPanic()
+
+			b.ResetTimer()
+			insertTransfers(z, b.N)
+		})
+	}
+}
+
+func BenchmarkDBBackend_GetAccountBlocksByHeight(b *testing.B) {
+	for _, backend := range dbBackends {
+		b.Run(backend, func(b *testing.B) {
+			z := mock.NewMockZenonWithDBBackend(b, backend)
+			defer z.StopPanic()
+			insertTransfers(z, 200)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i += 1 {
+				blocks, err := z.Chain().GetFrontierMomentumStore().GetAccountBlocksByHeight(g.User1.Address, uint64(i%150)+1, 50)
+				common.FailIfErr(b, err)
+				if blocks[len(blocks)-1] == nil {
+					b.Fatalf("expected 50 account-blocks from height %v", uint64(i%150)+1)
+				}
+			}
+		})
+	}
+}
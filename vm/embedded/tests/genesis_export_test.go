@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/genesis"
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+func exportedBalance(config *genesis.GenesisConfig, address types.Address, zts types.ZenonTokenStandard) *big.Int {
+	for _, block := range config.GenesisBlocks.Blocks {
+		if block.Address == address {
+			if amount, ok := block.BalanceList[zts]; ok {
+				return amount
+			}
+		}
+	}
+	return big.NewInt(0)
+}
+
+// Export the state of the mock chain after User1 stakes ZNN and fuses QSR
+// - the exported genesis is valid and uses the new chain identifier
+// - the staked ZNN goes back to User1
+// - the fusion is kept with the plasma contract balance
+func TestGenesis_Export(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     types.StakeContract,
+		Data:          definition.ABIStake.PackMethodPanic(definition.StakeMethodName, constants.StakeTimeMinSec),
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     types.PlasmaContract,
+		Data:          definition.ABIPlasma.PackMethodPanic(definition.FuseMethodName, g.User1.Address),
+		TokenStandard: types.QsrTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+
+	momentumStore := z.Chain().GetFrontierMomentumStore()
+	config, err := genesis.ExportGenesis(momentumStore, &genesis.ExportConfig{
+		ChainIdentifier:     7,
+		GenesisTimestampSec: 1000000000,
+	})
+	common.FailIfErr(t, err)
+	common.FailIfErr(t, genesis.CheckGenesis(config))
+	common.ExpectUint64(t, genesis.NewGenesis(config).ChainIdentifier(), 7)
+
+	balance, err := momentumStore.GetAccountStore(g.User1.Address).GetBalance(types.ZnnTokenStandard)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, exportedBalance(config, g.User1.Address, types.ZnnTokenStandard).String(), new(big.Int).Add(balance, big.NewInt(10*g.Zexp)).String())
+
+	pillars, err := momentumStore.GetActivePillars()
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(config.PillarConfig.Pillars)), uint64(len(pillars)))
+	fused := false
+	for _, fusion := range config.PlasmaConfig.Fusions {
+		if fusion.Owner == g.User1.Address && fusion.Beneficiary == g.User1.Address && fusion.Amount.Cmp(big.NewInt(10*g.Zexp)) == 0 {
+			fused = true
+		}
+	}
+	common.ExpectTrue(t, fused)
+
+	// the mainnet parameters are derived by the new chain
+	common.ExpectTrue(t, config.ContractParameters == nil)
+	common.ExpectTrue(t, config.RewardSchedule == nil)
+}
+
+// Export a chain with custom contract parameters and reward schedule
+// - both sections are exported
+// - the new chain, read from the json of the exported genesis, has the same parameters and rewards
+func TestGenesis_ExportParameters(t *testing.T) {
+	source := *g.EmbeddedGenesis
+	source.ContractParameters = &genesis.ContractParametersConfig{
+		StakeMinAmount:   big.NewInt(5 * g.Zexp),
+		TokenIssueAmount: big.NewInt(2 * g.Zexp),
+	}
+	source.RewardSchedule = &genesis.RewardScheduleConfig{
+		TickDurationInEpochs:           2,
+		ZnnPerEpoch:                    []*big.Int{big.NewInt(100 * g.Zexp), big.NewInt(0)},
+		QsrPerEpoch:                    []*big.Int{big.NewInt(200 * g.Zexp), big.NewInt(50 * g.Zexp), big.NewInt(0)},
+		DelegationZnnPercentage:        25,
+		MomentumProducingZnnPercentage: 25,
+		SentinelZnnPercentage:          25,
+		LiquidityZnnPercentage:         25,
+		StakingQsrPercentage:           50,
+		SentinelQsrPercentage:          25,
+		LiquidityQsrPercentage:         25,
+	}
+	common.FailIfErr(t, genesis.CheckGenesis(&source))
+	z := mock.NewMockZenonWithCustomGenesis(t, &source)
+	defer z.StopPanic()
+
+	config, err := genesis.ExportGenesis(z.Chain().GetFrontierMomentumStore(), &genesis.ExportConfig{
+		ChainIdentifier:     7,
+		GenesisTimestampSec: 1000000000,
+	})
+	common.FailIfErr(t, err)
+	common.ExpectString(t, config.ContractParameters.StakeMinAmount.String(), big.NewInt(5*g.Zexp).String())
+	common.ExpectString(t, config.RewardSchedule.QsrPerEpoch[1].String(), big.NewInt(50*g.Zexp).String())
+
+	data, err := json.Marshal(config)
+	common.FailIfErr(t, err)
+	decoded := new(genesis.GenesisConfig)
+	common.FailIfErr(t, json.Unmarshal(data, decoded))
+	common.FailIfErr(t, genesis.CheckGenesis(decoded))
+
+	expected := z.Chain().GetChainParams()
+	params := genesis.NewGenesis(decoded).GetChainParams()
+	common.ExpectString(t, params.StakeMinAmount.String(), expected.StakeMinAmount.String())
+	common.ExpectString(t, params.TokenIssueAmount.String(), expected.TokenIssueAmount.String())
+	common.ExpectString(t, params.PillarStakeAmount.String(), expected.PillarStakeAmount.String())
+	common.ExpectUint64(t, uint64(params.StakingQsrRewardPercentage), 50)
+	for _, epoch := range []uint64{0, 1, 2, 3, 4, 100} {
+		znn, qsr := params.CumulatedNetworkRewards(epoch)
+		expectedZnn, expectedQsr := expected.CumulatedNetworkRewards(epoch)
+		common.ExpectString(t, znn.String(), expectedZnn.String())
+		common.ExpectString(t, qsr.String(), expectedQsr.String())
+	}
+}
+
+// Export only QSR balances of at least 10000 QSR
+// - no pillars are exported without ZNN
+// - the QSR total supply matches the exported balances
+func TestGenesis_ExportFilters(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+
+	minBalance := big.NewInt(10000 * g.Zexp)
+	config, err := genesis.ExportGenesis(z.Chain().GetFrontierMomentumStore(), &genesis.ExportConfig{
+		ChainIdentifier: 7,
+		MinBalance:      minBalance,
+		Tokens:          []types.ZenonTokenStandard{types.QsrTokenStandard},
+	})
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(config.PillarConfig.Pillars)), 0)
+	common.ExpectUint64(t, uint64(len(config.TokenConfig.Tokens)), 1)
+
+	total := big.NewInt(0)
+	for _, block := range config.GenesisBlocks.Blocks {
+		for zts, amount := range block.BalanceList {
+			common.ExpectString(t, zts.String(), types.QsrTokenStandard.String())
+			if block.Address != types.PlasmaContract {
+				common.ExpectTrue(t, amount.Cmp(minBalance) >= 0)
+			}
+			total.Add(total, amount)
+		}
+	}
+	common.ExpectString(t, config.TokenConfig.Tokens[0].TotalSupply.String(), total.String())
+	common.FailIfErr(t, genesis.CheckGenesis(config))
+}
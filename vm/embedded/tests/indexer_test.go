@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/indexer"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// searchAll follows the cursors of ledger.searchAccountBlocks with pages of pageSize account-blocks
+func searchAll(t *testing.T, ledgerApi *api.LedgerApi, filter *indexer.AccountBlockFilter, pageSize uint64) []types.Hash {
+	hashes := make([]types.Hash, 0)
+	cursor := ""
+	for {
+		result, err := ledgerApi.SearchAccountBlocks(filter, cursor, pageSize)
+		common.FailIfErr(t, err)
+		for _, block := range result.List {
+			hashes = append(hashes, block.Hash)
+		}
+		if len(result.Cursor) == 0 {
+			return hashes
+		}
+		cursor = result.Cursor
+	}
+}
+
+func expectHashes(t *testing.T, hashes []types.Hash, expected ...types.Hash) {
+	if len(hashes) != len(expected) {
+		t.Fatalf("expected %v account-blocks but got %v", expected, hashes)
+	}
+	for i := range hashes {
+		common.ExpectString(t, hashes[i].String(), expected[i].String())
+	}
+}
+
+func frontierBlock(t *testing.T, z mock.MockZenon, address types.Address) *nom.AccountBlock {
+	block, err := z.Chain().GetFrontierAccountStore(address).Frontier()
+	common.FailIfErr(t, err)
+	return block
+}
+
+// - the account-blocks are found by counterparty, token, embedded method and momentum timestamp
+// - the cursors return the same account-blocks as a single page
+func TestIndexer_SearchAccountBlocks(t *testing.T) {
+	z := mock.NewMockZenonWithIndexer(t)
+	defer z.StopPanic()
+	ledgerApi := api.NewLedgerApi(z)
+
+	send := z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	autoreceive(t, z, g.User2.Address)
+	z.InsertNewMomentum()
+	receive := frontierBlock(t, z, g.User2.Address)
+	defer z.CallContract(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     types.StakeContract,
+		Data:          definition.ABIStake.PackMethodPanic(definition.StakeMethodName, constants.StakeTimeMinSec),
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}).Error(t, nil)
+	stake := frontierBlock(t, z, g.User1.Address)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+	z.WaitIndexer()
+
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Address:      &g.User1.Address,
+		Counterparty: &g.User2.Address,
+	}, 10), send.Hash)
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Address:       &g.User2.Address,
+		TokenStandard: &types.ZnnTokenStandard,
+	}, 10), receive.Hash)
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Contract: &types.StakeContract,
+		Method:   definition.StakeMethodName,
+	}, 10), stake.Hash)
+
+	second, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(2)
+	common.FailIfErr(t, err)
+	third, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(3)
+	common.FailIfErr(t, err)
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Address:       &g.User1.Address,
+		FromTimestamp: third.Timestamp.Unix(),
+	}, 10), stake.Hash)
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Address:       &g.User1.Address,
+		FromTimestamp: second.Timestamp.Unix(),
+		ToTimestamp:   third.Timestamp.Unix(),
+	}, 10), send.Hash)
+
+	all := searchAll(t, ledgerApi, nil, api.RpcMaxCountSize)
+	expectHashes(t, searchAll(t, ledgerApi, nil, 1), all...)
+	expectHashes(t, searchAll(t, ledgerApi, &indexer.AccountBlockFilter{
+		Counterparty: &types.StakeContract,
+	}, 1), stake.Hash)
+
+	_, err = ledgerApi.SearchAccountBlocks(&indexer.AccountBlockFilter{Method: definition.StakeMethodName}, "", 10)
+	common.ExpectError(t, err, indexer.ErrMethodIncomplete)
+	_, err = ledgerApi.SearchAccountBlocks(&indexer.AccountBlockFilter{Contract: &types.StakeContract, Method: "Unknown"}, "", 10)
+	common.ExpectError(t, errors.Cause(err), indexer.ErrUnknownMethod)
+	_, err = ledgerApi.SearchAccountBlocks(nil, "00", 10)
+	common.ExpectError(t, err, api.ErrCursorParamInvalid)
+
+	disabled := mock.NewMockZenon(t)
+	defer disabled.StopPanic()
+	_, err = api.NewLedgerApi(disabled).SearchAccountBlocks(nil, "", 10)
+	common.ExpectError(t, err, api.ErrIndexerDisabled)
+}
+
+// - the momentums removed by a rollback are removed from the indexes
+func TestIndexer_Rollback(t *testing.T) {
+	z := mock.NewMockZenonWithIndexer(t)
+	defer z.StopPanic()
+	ledgerApi := api.NewLedgerApi(z)
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertMomentumsTo(10)
+	z.WaitIndexer()
+	filter := &indexer.AccountBlockFilter{Counterparty: &g.User2.Address}
+	common.ExpectUint64(t, uint64(len(searchAll(t, ledgerApi, filter, 10))), 1)
+
+	target, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(1)
+	common.FailIfErr(t, err)
+	insert := z.Chain().AcquireInsert("test rollback")
+	common.FailIfErr(t, z.Chain().RollbackTo(insert, target.Identifier()))
+	insert.Unlock()
+	z.InsertNewMomentum()
+	z.WaitIndexer()
+	common.ExpectUint64(t, uint64(len(searchAll(t, ledgerApi, filter, 10))), 0)
+	common.ExpectUint64(t, z.Indexer().Frontier().Height, 2)
+	history, err := ledgerApi.GetBalanceHistory(g.User1.Address, types.ZnnTokenStandard, 1, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(history.List)), 1)
+}
+
+// - the balance changes are recorded with the momentum height and the account-blocks which caused them
+// - the daily balances are the balances confirmed by the last momentum of each day
+func TestIndexer_BalanceHistory(t *testing.T) {
+	z := mock.NewMockZenonWithIndexer(t)
+	defer z.StopPanic()
+	ledgerApi := api.NewLedgerApi(z)
+
+	send := func(amount int64) {
+		z.InsertSendBlock(&nom.AccountBlock{
+			Address:       g.User1.Address,
+			ToAddress:     g.User2.Address,
+			TokenStandard: types.ZnnTokenStandard,
+			Amount:        big.NewInt(amount * g.Zexp),
+		}, nil, mock.SkipVmChanges)
+		z.InsertNewMomentum()
+		autoreceive(t, z, g.User2.Address)
+		z.InsertNewMomentum()
+	}
+	send(10)
+	z.InsertMomentumAfterMissedSlots(8640)
+	send(5)
+	z.WaitIndexer()
+
+	common.Json(ledgerApi.GetBalanceHistory(g.User2.Address, types.ZnnTokenStandard, 1, 100)).Equals(t, `
+{
+	"list": [
+		{
+			"momentumHeight": 1,
+			"balance": "800000000000",
+			"blocks": [
+				"57b6b7c6edb82b38ec4c992d99c84bf8016f03bf0727ff9daa811d2e862fa77a"
+			]
+		},
+		{
+			"momentumHeight": 3,
+			"balance": "801000000000",
+			"blocks": [
+				"108cf5c6e1206c853c7e85611bb93ce33a84a83d14a46cdc6745d8c7f1b52c2d"
+			]
+		},
+		{
+			"momentumHeight": 6,
+			"balance": "801500000000",
+			"blocks": [
+				"48e6b15a0178cd503013730bb5edd87f87e667482d2195c8d6c298d79ad57401"
+			]
+		}
+	],
+	"more": false,
+	"indexedHeight": 6
+}`)
+	common.Json(ledgerApi.GetBalanceHistory(g.User1.Address, types.ZnnTokenStandard, 2, 100)).Equals(t, `
+{
+	"list": [
+		{
+			"momentumHeight": 2,
+			"balance": "1199000000000",
+			"blocks": [
+				"a49b936608ec189f6a6f3bb5f55f2173191484454860b04590fa628134aa7b99"
+			]
+		},
+		{
+			"momentumHeight": 5,
+			"balance": "1198500000000",
+			"blocks": [
+				"47282ccca55489b59c48d32deebedd125e7b2d4b965bcd07d66ecd68db1f32f6"
+			]
+		}
+	],
+	"more": false,
+	"indexedHeight": 6
+}`)
+	changes, more, err := z.Indexer().GetBalanceHistory(g.User2.Address, types.ZnnTokenStandard, 1, 100, 2)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(changes)), 2)
+	common.ExpectTrue(t, more)
+
+	genesis := z.Chain().GetGenesisMomentum().Timestamp.Unix()
+	common.Json(ledgerApi.GetDailyBalances(g.User2.Address, types.ZnnTokenStandard, genesis, genesis+10*24*60*60)).Equals(t, `
+{
+	"list": [
+		{
+			"day": 999993600,
+			"momentumHeight": 3,
+			"balance": "801000000000"
+		},
+		{
+			"day": 1000080000,
+			"momentumHeight": 6,
+			"balance": "801500000000"
+		}
+	],
+	"indexedHeight": 6
+}`)
+	_, err = ledgerApi.GetDailyBalances(g.User2.Address, types.ZnnTokenStandard, 0, genesis)
+	common.ExpectError(t, err, api.ErrTimeRangeTooBig)
+}
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/verifier"
+)
+
+// corruptedChain replaces the patch of a momentum and hides an account-block
+type corruptedChain struct {
+	chain.Chain
+	patchHeight uint64
+	missing     types.Hash
+}
+
+func (c *corruptedChain) GetMomentumChanges(identifier types.HashHeight) (db.Patch, error) {
+	if identifier.Height == c.patchHeight {
+		patch := db.NewPatch()
+		patch.Put([]byte{100}, []byte{1})
+		return patch, nil
+	}
+	return c.Chain.GetMomentumChanges(identifier)
+}
+func (c *corruptedChain) GetFrontierMomentumStore() store.Momentum {
+	return &corruptedStore{
+		Momentum: c.Chain.GetFrontierMomentumStore(),
+		missing:  c.missing,
+	}
+}
+
+type corruptedStore struct {
+	store.Momentum
+	missing types.Hash
+}
+
+func (s *corruptedStore) GetAccountBlock(header types.AccountHeader) (*nom.AccountBlock, error) {
+	if header.Hash == s.missing {
+		return nil, nil
+	}
+	return s.Momentum.GetAccountBlock(header)
+}
+
+// - a consistent chain has no corruption
+// - the first corrupted height is reported
+func TestIntegrity_Verify(t *testing.T) {
+	z := newArchiveSource(t)
+	defer z.StopPanic()
+
+	corruption, err := verifier.VerifyIntegrity(z.Chain(), 0, nil)
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, corruption == nil)
+
+	momentum, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(3)
+	common.FailIfErr(t, err)
+	corrupted := &corruptedChain{
+		Chain:       z.Chain(),
+		patchHeight: 20,
+		missing:     momentum.Content[0].Hash,
+	}
+	corruption, err = verifier.VerifyIntegrity(corrupted, 0, nil)
+	common.Json(corruption, err).Equals(t, `
+{
+	"height": 3,
+	"reason": "missing account-block 108cf5c6e1206c853c7e85611bb93ce33a84a83d14a46cdc6745d8c7f1b52c2d"
+}`)
+	corruption, err = verifier.VerifyIntegrity(corrupted, 4, nil)
+	common.Json(corruption, err).Equals(t, `
+{
+	"height": 20,
+	"reason": "momentum changes-hash is different than the one computed"
+}`)
+}
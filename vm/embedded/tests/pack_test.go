@@ -109,7 +109,7 @@ func String(length int) string {
 }
 
 func TestPack_SimpleTest(t *testing.T) {
-	context := vm_context.NewGenesisMomentumVMContext()
+	context := vm_context.NewGenesisMomentumVMContext(constants.DefaultChainParams())
 	storage := context.GetAccountStore(g.User1.Address).Storage()
 	variable, err := GetComplexDataStructureVariable(storage, "znn")
 	assert.Nil(t, err)
@@ -154,7 +154,7 @@ func generateComplexDataStructure(title string) *ComplexDataStructureVariable {
 }
 
 func TestPack_ComplexTest(t *testing.T) {
-	context := vm_context.NewGenesisMomentumVMContext()
+	context := vm_context.NewGenesisMomentumVMContext(constants.DefaultChainParams())
 	storage := context.GetAccountStore(g.User1.Address).Storage()
 	variable, err := GetComplexDataStructureVariable(storage, "znn")
 	assert.Nil(t, err)
@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/rpc/api/embedded"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+func TestParameters_RewardEmission(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	parametersApi := embedded.NewParametersApi(z)
+
+	common.Json(parametersApi.GetRewardEmission(0)).Equals(t, `
+{
+	"epoch": 0,
+	"znn": "1440000000000",
+	"znnDelegation": "345600000000",
+	"znnMomentumProducing": "719999997120",
+	"znnSentinel": "187200000000",
+	"znnLiquidity": "187200000000",
+	"cumulatedZnn": "1440000000000",
+	"qsr": "2000000000000",
+	"qsrStake": "1000000000000",
+	"qsrSentinel": "500000000000",
+	"qsrLiquidity": "500000000000",
+	"cumulatedQsr": "2000000000000"
+}`)
+	common.Json(parametersApi.GetRewardEmission(1000)).Equals(t, `
+{
+	"epoch": 1000,
+	"znn": "432000000000",
+	"znnDelegation": "103680000000",
+	"znnMomentumProducing": "216000000000",
+	"znnSentinel": "56160000000",
+	"znnLiquidity": "56160000000",
+	"cumulatedZnn": "553392000000000",
+	"qsr": "500000000000",
+	"qsrStake": "250000000000",
+	"qsrSentinel": "125000000000",
+	"qsrLiquidity": "125000000000",
+	"cumulatedQsr": "770500000000000"
+}`)
+}
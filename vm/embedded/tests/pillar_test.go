@@ -527,7 +527,10 @@ t=2001-09-09T02:20:00+0000 lvl=info msg="Updating pillar producer address" modul
 // Revoke pillar 4
 // Register a new pillar with the name of pillar 4 (should fail since an inactive pillar owns the name)
 func TestPillar_RegisterRevokeRegisterPillar(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, func(params *constants.ChainParams) {
+		params.PillarEpochRevokeTime = 60
+		params.PillarEpochLockTime = 60
+	})
 	pillarApi := embedded.NewPillarApi(z, true)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
@@ -551,12 +554,6 @@ t=2001-09-09T02:46:50+0000 lvl=dbug msg="minted ZTS" module=embedded contract=to
 t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded contract=common embedded=z1qxemdeddedxlyquydytyxxxxxxxxxxxxflaaae from-address=z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0 zts=zts1znnxxxxxxxxxxxxx9z4ulx amount=187200000000
 t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded contract=common embedded=z1qxemdeddedxlyquydytyxxxxxxxxxxxxflaaae from-address=z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0 zts=zts1qsrxxxxxxxxxxxxxmrhjll amount=500000000000
 `)
-	defer func() {
-		constants.PillarEpochRevokeTime = 28800
-		constants.PillarEpochLockTime = 144000
-	}()
-	constants.PillarEpochRevokeTime = 60
-	constants.PillarEpochLockTime = 60
 
 	common.Json(pillarApi.GetQsrRegistrationCost()).Equals(t, `"15000000000000"`)
 	// deposit QSR for Pillar 4
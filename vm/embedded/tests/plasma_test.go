@@ -390,14 +390,15 @@ t=2001-09-09T01:46:50+0000 lvl=dbug msg="canceled fusion entry" module=embedded
 // - revoke newly-fused plasma entry
 // - auto-receive QSR to initial funds
 func TestPlasma_RevokeFusedPlasma(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, func(params *constants.ChainParams) {
+		params.FuseExpiration = 30
+	})
 	plasmaApi := embedded.NewPlasmaApi(z)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg="fused new entry" module=embedded contract=plasma fusionInfo="{Owner:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Amount:+1000000000 ExpirationHeight:32 Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz}" beneficiary="&{Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Amount:+1001000000000}"
 t=2001-09-09T01:52:10+0000 lvl=dbug msg="canceled fusion entry" module=embedded contract=plasma fusionInfo="&{Owner:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Amount:+1000000000 ExpirationHeight:32 Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz}" beneficiary-remaining="&{Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Amount:+1000000000000}"
 `)
-	constants.FuseExpiration = 30
 
 	common.Json(plasmaApi.GetEntriesByAddress(g.User1.Address, 0, 10)).Equals(t, `
 {
@@ -473,9 +474,10 @@ t=2001-09-09T01:52:10+0000 lvl=dbug msg="canceled fusion entry" module=embedded
 // - future fuse entries do not increase
 // - canceling a fuse works as expected
 func TestPlasma_FuseMaxPlasma(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, func(params *constants.ChainParams) {
+		params.FuseExpiration = 10
+	})
 	plasmaApi := embedded.NewPlasmaApi(z)
-	constants.FuseExpiration = 10
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg="fused new entry" module=embedded contract=plasma fusionInfo="{Owner:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Amount:+350000000000 ExpirationHeight:12 Beneficiary:z1qqdt06lnwz57x38rwlyutcx5wgrtl0ynkfe3kv}" beneficiary="&{Beneficiary:z1qqdt06lnwz57x38rwlyutcx5wgrtl0ynkfe3kv Amount:+350000000000}"
@@ -563,12 +565,13 @@ t=2001-09-09T01:51:40+0000 lvl=dbug msg="canceled fusion entry" module=embedded
 // - limit plasma.expiration to 100
 // - try to cancel entry after 50 momentums => "staking period still active"
 func TestPlasma_TooEarlyRevoke(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, func(params *constants.ChainParams) {
+		params.FuseExpiration = 100
+	})
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg="fused new entry" module=embedded contract=plasma fusionInfo="{Owner:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Amount:+10000000000000 ExpirationHeight:102 Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz}" beneficiary="&{Beneficiary:z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz Amount:+11000000000000}"
 `)
-	constants.FuseExpiration = 100
 
 	defer z.CallContract(&nom.AccountBlock{
 		Address:       g.User1.Address,
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// - the states older than the retention window are pruned
+// - the bodies of the received send blocks are pruned
+// - the account frontiers and the unreceived send blocks are kept
+func TestPruning_RetentionWindow(t *testing.T) {
+	z := mock.NewMockZenonWithPruning(t, 20)
+	defer z.StopPanic()
+
+	received := z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+	receive := z.InsertReceiveBlock(received.Header(), nil, nil, mock.SkipVmChanges)
+	unreceived := z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User3.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	z.InsertNewMomentum()
+
+	old, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(2)
+	common.FailIfErr(t, err)
+	z.InsertMomentumsTo(60)
+
+	momentumStore, err := z.Chain().GetMomentumStore(old.Identifier())
+	common.ExpectError(t, err, db.ErrPruned)
+	common.ExpectTrue(t, momentumStore == nil)
+	recent, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(50)
+	common.FailIfErr(t, err)
+	momentumStore, err = z.Chain().GetMomentumStore(recent.Identifier())
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, momentumStore != nil)
+
+	frontierStore := z.Chain().GetFrontierMomentumStore()
+	block, err := frontierStore.GetAccountBlockByHash(received.Hash)
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, block == nil)
+	block, err = frontierStore.GetAccountBlockByHash(unreceived.Hash)
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, block != nil)
+	block, err = frontierStore.GetAccountBlockByHash(receive.Hash)
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, block != nil)
+
+	_, err = z.Chain().GetAccountStore(g.User1.Address, received.Identifier())
+	common.ExpectError(t, err, db.ErrPruned)
+	z.ExpectBalance(g.User2.Address, types.ZnnTokenStandard, 8010*g.Zexp)
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/zenon-network/go-zenon/chain"
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// - the summary counts the removed momentums and account-blocks
+// - the rollback can't cross the finality depth
+// - the rollback restores the state of the target momentum
+func TestRollback_PlanAndRollback(t *testing.T) {
+	z := newArchiveSource(t)
+	defer z.StopPanic()
+
+	target, err := z.Chain().GetFrontierMomentumStore().GetMomentumByHeight(1)
+	common.FailIfErr(t, err)
+	_, err = chain.PlanRollback(z.Chain(), target.Identifier(), 20)
+	common.ExpectError(t, errors.Cause(err), chain.ErrRollbackTooDeep)
+
+	summary, err := chain.PlanRollback(z.Chain(), target.Identifier(), 100)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, summary.Momentums, 29)
+	common.ExpectUint64(t, summary.AccountBlocks, 2)
+
+	frontier := z.Chain().GetFrontierMomentumStore().Identifier()
+	_, err = chain.PlanRollback(z.Chain(), frontier, 100)
+	common.ExpectError(t, errors.Cause(err), chain.ErrRollbackTargetFrontier)
+	_, err = chain.PlanRollback(z.Chain(), types.HashHeight{Height: 10}, 100)
+	common.ExpectError(t, errors.Cause(err), chain.ErrRollbackTargetMissing)
+
+	insert := z.Chain().AcquireInsert("test rollback")
+	common.FailIfErr(t, z.Chain().RollbackTo(insert, summary.To))
+	insert.Unlock()
+	common.ExpectUint64(t, z.Chain().GetFrontierMomentumStore().Identifier().Height, 1)
+	z.ExpectBalance(g.User2.Address, types.ZnnTokenStandard, 8000*g.Zexp)
+}
@@ -0,0 +1,308 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/consensus"
+	consensusapi "github.com/zenon-network/go-zenon/consensus/api"
+	"github.com/zenon-network/go-zenon/protocol"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/vm"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// - the current and next elections are available, with one slot per momentum of the tick
+// - the next election is derived from the last momentum of tick current-1
+// - elections after the next one can't be computed yet
+func TestRPCConsensus_Schedule(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	consensusApi := api.NewConsensusApi(z)
+
+	z.InsertMomentumsTo(40)
+
+	current, err := consensusApi.GetCurrentElection()
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, current.Tick, 1)
+	common.ExpectUint64(t, uint64(len(current.Producers)), 30)
+	common.ExpectUint64(t, uint64(current.Producers[0].StartTime), uint64(current.StartTime))
+	common.ExpectUint64(t, uint64(current.Producers[29].EndTime), uint64(current.EndTime))
+	common.Json(current.ProofMomentum, nil).Equals(t, `
+{
+	"hash": "0385d849ee33b94c8783288c148e3ae741c2ecec98b08b3f59d6bcc219168fe5",
+	"height": 1
+}`)
+
+	next, err := consensusApi.GetNextElection()
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, next.Tick, 2)
+	common.ExpectUint64(t, next.ProofMomentum.Height, 30)
+	common.ExpectString(t, next.Algorithm, "MAINNET")
+	common.Json(next.Delegations, nil).Equals(t, `
+[
+	{
+		"name": "TEST-pillar-1",
+		"producer": "z1qqq43dyrswfehx9w9td43exflqzcxrt7g6alah",
+		"weight": 2100000000000
+	},
+	{
+		"name": "TEST-pillar-cool",
+		"producer": "z1qz8v73ea2vy2rrlq7skssngu8cm8mknjjkr2ju",
+		"weight": 200000000000
+	},
+	{
+		"name": "TEST-pillar-znn",
+		"producer": "z1qqc8hqalt8je538849rf78nhgek30axq8h0g69",
+		"weight": 200000000000
+	}
+]`)
+
+	byTick, err := consensusApi.GetElectionByTick(2)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, byTick.Tick, next.Tick)
+	common.ExpectTrue(t, byTick.ProofMomentum == next.ProofMomentum)
+	_, err = consensusApi.GetElectionByTick(3)
+	common.ExpectError(t, err, consensus.ErrElectionNotComputed)
+
+	schedule, err := consensusApi.GetProducerSchedule(g.Pillar1.Address)
+	common.FailIfErr(t, err)
+	expected := 0
+	for _, election := range []*consensusapi.ElectionSchedule{current, next} {
+		for _, slot := range election.Producers {
+			if slot.Producer == g.Pillar1.Address {
+				expected += 1
+			}
+		}
+	}
+	common.ExpectUint64(t, uint64(len(schedule.Slots)), uint64(expected))
+	common.ExpectUint64(t, schedule.Slots[0].Tick, 1)
+	common.ExpectUint64(t, schedule.Slots[len(schedule.Slots)-1].Tick, 2)
+}
+
+type missedSlotSaver struct {
+	events []consensusapi.MissedSlot
+}
+
+func (s *missedSlotSaver) NewMissedSlot(event consensusapi.MissedSlot) {
+	s.events = append(s.events, event)
+}
+
+// - slots without a momentum are recorded as missed, the slot of the frontier momentum is decided
+// - the liveness window counts the consecutive missed slots since the last produced momentum
+// - a MissedSlot event is broadcast for each missed slot
+func TestRPCConsensus_Liveness(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	consensusApi := api.NewConsensusApi(z)
+	saver := &missedSlotSaver{}
+	z.Consensus().RegisterMissedSlotListener(saver)
+	defer z.Consensus().UnRegisterMissedSlotListener(saver)
+
+	z.InsertMomentumsTo(10)
+	z.InsertMomentumAfterMissedSlots(3)
+
+	slots, err := consensusApi.GetSlotsByTick(0)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(slots)), 13)
+	common.Json(slots[9:], nil).Equals(t, `
+[
+	{
+		"tick": 0,
+		"name": "TEST-pillar-znn",
+		"producer": "z1qqc8hqalt8je538849rf78nhgek30axq8h0g69",
+		"startTime": 1000000100,
+		"endTime": 1000000110,
+		"produced": false,
+		"momentum": null
+	},
+	{
+		"tick": 0,
+		"name": "TEST-pillar-1",
+		"producer": "z1qqq43dyrswfehx9w9td43exflqzcxrt7g6alah",
+		"startTime": 1000000110,
+		"endTime": 1000000120,
+		"produced": false,
+		"momentum": null
+	},
+	{
+		"tick": 0,
+		"name": "TEST-pillar-1",
+		"producer": "z1qqq43dyrswfehx9w9td43exflqzcxrt7g6alah",
+		"startTime": 1000000120,
+		"endTime": 1000000130,
+		"produced": false,
+		"momentum": null
+	},
+	{
+		"tick": 0,
+		"name": "TEST-pillar-znn",
+		"producer": "z1qqc8hqalt8je538849rf78nhgek30axq8h0g69",
+		"startTime": 1000000130,
+		"endTime": 1000000140,
+		"produced": true,
+		"momentum": {
+			"hash": "2358aafc5bf3c8721de88669bcc46615662fa453f5a0a0a5f2a7631837540b01",
+			"height": 11
+		}
+	}
+]`)
+	common.Json(consensusApi.GetPillarsLiveness()).Equals(t, `
+{
+	"fromTick": 0,
+	"toTick": 0,
+	"pillars": [
+		{
+			"name": "TEST-pillar-1",
+			"scheduledNum": 3,
+			"producedNum": 1,
+			"missedNum": 2,
+			"consecutiveMissed": 2,
+			"lastProducedTime": 1000000050
+		},
+		{
+			"name": "TEST-pillar-cool",
+			"scheduledNum": 6,
+			"producedNum": 6,
+			"missedNum": 0,
+			"consecutiveMissed": 0,
+			"lastProducedTime": 1000000080
+		},
+		{
+			"name": "TEST-pillar-znn",
+			"scheduledNum": 4,
+			"producedNum": 3,
+			"missedNum": 1,
+			"consecutiveMissed": 0,
+			"lastProducedTime": 1000000130
+		}
+	]
+}`)
+	common.Json(saver.events, nil).Equals(t, `
+[
+	{
+		"slot": {
+			"tick": 0,
+			"name": "TEST-pillar-znn",
+			"producer": "z1qqc8hqalt8je538849rf78nhgek30axq8h0g69",
+			"startTime": 1000000100,
+			"endTime": 1000000110,
+			"produced": false,
+			"momentum": null
+		},
+		"consecutiveMissed": 1
+	},
+	{
+		"slot": {
+			"tick": 0,
+			"name": "TEST-pillar-1",
+			"producer": "z1qqq43dyrswfehx9w9td43exflqzcxrt7g6alah",
+			"startTime": 1000000110,
+			"endTime": 1000000120,
+			"produced": false,
+			"momentum": null
+		},
+		"consecutiveMissed": 1
+	},
+	{
+		"slot": {
+			"tick": 0,
+			"name": "TEST-pillar-1",
+			"producer": "z1qqq43dyrswfehx9w9td43exflqzcxrt7g6alah",
+			"startTime": 1000000120,
+			"endTime": 1000000130,
+			"produced": false,
+			"momentum": null
+		},
+		"consecutiveMissed": 2
+	}
+]`)
+
+	z.InsertNewMomentum()
+	common.Json(consensusApi.GetPillarsLiveness()).Equals(t, `
+{
+	"fromTick": 0,
+	"toTick": 0,
+	"pillars": [
+		{
+			"name": "TEST-pillar-1",
+			"scheduledNum": 4,
+			"producedNum": 2,
+			"missedNum": 2,
+			"consecutiveMissed": 0,
+			"lastProducedTime": 1000000140
+		},
+		{
+			"name": "TEST-pillar-cool",
+			"scheduledNum": 6,
+			"producedNum": 6,
+			"missedNum": 0,
+			"consecutiveMissed": 0,
+			"lastProducedTime": 1000000080
+		},
+		{
+			"name": "TEST-pillar-znn",
+			"scheduledNum": 4,
+			"producedNum": 3,
+			"missedNum": 1,
+			"consecutiveMissed": 0,
+			"lastProducedTime": 1000000130
+		}
+	]
+}`)
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+// - the missed slots of the momentums inserted by the sync aren't broadcast
+// - the liveness window still counts them
+// - the missed slots are broadcast again once the node is in sync
+func TestRPCConsensus_LivenessSync(t *testing.T) {
+	source := mock.NewMockZenon(t)
+	defer source.StopPanic()
+	source.InsertMomentumsTo(10)
+	source.InsertMomentumAfterMissedSlots(3)
+	sourceBridge := protocol.NewChainBridge(source.Chain(), source.Consensus(), source.Verifier(), vm.NewSupervisor(source.Chain(), source.Consensus()))
+	momentums := make([]*nom.DetailedMomentum, 0)
+	for height := uint64(2); height <= 11; height += 1 {
+		momentum, err := sourceBridge.GetBlockByNumber(height)
+		common.FailIfErr(t, err)
+		momentums = append(momentums, sourceBridge.GetBlock(momentum.Hash))
+	}
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	consensusApi := api.NewConsensusApi(z)
+	saver := &missedSlotSaver{}
+	z.Consensus().RegisterMissedSlotListener(saver)
+	defer z.Consensus().UnRegisterMissedSlotListener(saver)
+
+	// the node syncs a day later
+	clock := common.Clock
+	common.Clock = &fixedClock{now: time.Unix(1000000000, 0).Add(24 * time.Hour)}
+	bridge := protocol.NewChainBridge(z.Chain(), z.Consensus(), z.Verifier(), vm.NewSupervisor(z.Chain(), z.Consensus()))
+	_, err := bridge.InsertChain(momentums)
+	common.Clock = clock
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(len(saver.events)), 0)
+
+	window, err := consensusApi.GetPillarsLiveness()
+	common.FailIfErr(t, err)
+	missed := uint64(0)
+	for _, pillar := range window.Pillars {
+		missed += pillar.MissedNum
+	}
+	common.ExpectUint64(t, missed, 3)
+
+	z.InsertMomentumAfterMissedSlots(2)
+	common.ExpectUint64(t, uint64(len(saver.events)), 2)
+}
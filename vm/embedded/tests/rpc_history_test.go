@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/rpc/api/embedded"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+// - the balances are read from the state confirmed by the momentum
+// - the unconfirmed account-blocks are not part of the historical state
+func TestRPCHistory_AccountInfoAt(t *testing.T) {
+	z := newArchiveSource(t)
+	defer z.StopPanic()
+	ledgerApi := api.NewLedgerApi(z)
+
+	info, err := ledgerApi.GetAccountInfoAt(g.User2.Address, 2)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, info.BalanceInfoMap[types.ZnnTokenStandard].Balance.String(), "800000000000")
+	info, err = ledgerApi.GetAccountInfoAt(g.User2.Address, 3)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, info.BalanceInfoMap[types.ZnnTokenStandard].Balance.String(), "801000000000")
+	info, err = ledgerApi.GetAccountInfoAt(g.User1.Address, 1)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, info.BalanceInfoMap[types.ZnnTokenStandard].Balance.String(), "1200000000000")
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     g.User2.Address,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}, nil, mock.SkipVmChanges)
+	info, err = ledgerApi.GetAccountInfoAt(g.User1.Address, 30)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, info.BalanceInfoMap[types.ZnnTokenStandard].Balance.String(), "1199000000000")
+	info, err = ledgerApi.GetAccountInfoByAddress(g.User1.Address)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, info.BalanceInfoMap[types.ZnnTokenStandard].Balance.String(), "1198000000000")
+
+	_, err = ledgerApi.GetAccountInfoAt(g.User1.Address, 0)
+	common.ExpectError(t, err, api.ErrHeightParamIsZero)
+	_, err = ledgerApi.GetAccountInfoAt(g.User1.Address, 31)
+	common.ExpectError(t, err, api.ErrMomentumHeightNotFound)
+}
+
+// - the embedded contracts are read from the state confirmed by the momentum
+func TestRPCHistory_EmbeddedAt(t *testing.T) {
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	stakeApi := embedded.NewStakeApi(z)
+	tokenApi := embedded.NewTokenApi(z)
+	pillarApi := embedded.NewPillarApi(z, true)
+	plasmaApi := embedded.NewPlasmaApi(z)
+
+	z.InsertNewMomentum()
+	defer z.CallContract(&nom.AccountBlock{
+		Address:       g.User1.Address,
+		ToAddress:     types.StakeContract,
+		Data:          definition.ABIStake.PackMethodPanic(definition.StakeMethodName, constants.StakeTimeMinSec),
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(10 * g.Zexp),
+	}).Error(t, nil)
+	z.InsertNewMomentum()
+	z.InsertNewMomentum()
+
+	stakes, err := stakeApi.GetEntriesByAddressAt(g.User1.Address, 2, 0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(stakes.Count), 0)
+	stakes, err = stakeApi.GetEntriesByAddressAt(g.User1.Address, 4, 0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(stakes.Count), 1)
+	common.ExpectString(t, stakes.TotalAmount.String(), "1000000000")
+
+	fusions, err := plasmaApi.GetEntriesByAddressAt(g.User1.Address, 4, 0, 10)
+	common.FailIfErr(t, err)
+	frontierFusions, err := plasmaApi.GetEntriesByAddress(g.User1.Address, 0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(fusions.Count), uint64(frontierFusions.Count))
+
+	token, err := tokenApi.GetByZtsAt(types.ZnnTokenStandard, 1)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, token.TokenSymbol, "ZNN")
+	token, err = tokenApi.GetByZtsAt(types.ZeroTokenStandard, 1)
+	common.FailIfErr(t, err)
+	common.ExpectTrue(t, token == nil)
+
+	pillars, err := pillarApi.GetAllAt(1, 0, 10)
+	common.FailIfErr(t, err)
+	frontierPillars, err := pillarApi.GetAll(0, 10)
+	common.FailIfErr(t, err)
+	common.ExpectUint64(t, uint64(pillars.Count), uint64(frontierPillars.Count))
+	common.ExpectTrue(t, pillars.List[0].Weight.Sign() > 0)
+}
+
+// - the states deleted by the pruning mode can't be queried
+func TestRPCHistory_Pruned(t *testing.T) {
+	z := mock.NewMockZenonWithPruning(t, 20)
+	defer z.StopPanic()
+	ledgerApi := api.NewLedgerApi(z)
+	z.InsertMomentumsTo(60)
+
+	_, err := ledgerApi.GetAccountInfoAt(g.User1.Address, 2)
+	common.ExpectError(t, err, api.ErrMomentumHeightPruned)
+	_, err = ledgerApi.GetAccountInfoAt(g.User1.Address, 50)
+	common.FailIfErr(t, err)
+}
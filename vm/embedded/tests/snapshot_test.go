@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common"
+	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/protocol"
+	"github.com/zenon-network/go-zenon/verifier"
+	"github.com/zenon-network/go-zenon/vm"
+	"github.com/zenon-network/go-zenon/zenon/mock"
+)
+
+func newSnapshotBridge(z mock.MockZenon) protocol.ChainBridge {
+	return protocol.NewChainBridge(z.Chain(), z.Consensus(), verifier.NewVerifier(z.Chain(), z.Consensus()), vm.NewSupervisor(z.Chain(), z.Consensus()))
+}
+
+// snapshotFetcher fetches the chunks of the snapshot of identifier from bridge, through the network encoding
+func snapshotFetcher(bridge protocol.ChainBridge, identifier types.HashHeight) protocol.SnapshotFetcher {
+	return func(space protocol.SnapshotSpace, from []byte) (*protocol.SnapshotChunk, error) {
+		chunk, err := bridge.GetSnapshotChunk(identifier, space, from)
+		if err != nil {
+			return nil, err
+		}
+		data, err := rlp.EncodeToBytes(chunk)
+		if err != nil {
+			return nil, err
+		}
+		decoded := new(protocol.SnapshotChunk)
+		return decoded, rlp.DecodeBytes(data, decoded)
+	}
+}
+
+// downloadSnapshot downloads the snapshot of the checkpoint served by bridge
+func downloadSnapshot(t *testing.T, checkpoint *protocol.SnapshotCheckpoint, bridge protocol.ChainBridge) (db.DB, db.DB) {
+	state := db.NewMemDB()
+	consensusEntries := db.NewMemDB()
+	common.FailIfErr(t, protocol.DownloadSnapshot(checkpoint, snapshotFetcher(bridge, checkpoint.Identifier()), state, consensusEntries))
+	return state, consensusEntries
+}
+
+// - the checkpoint survives its text format
+// - the state and the consensus entries of a momentum survive the network encoding and match the checkpoint
+// - the installed state has the balances of the source and the history before it is pruned
+// - the normal sync continues from the snapshot momentum
+// - the installed node serves the same snapshot
+func TestSnapshot_Install(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+	sourceBridge := newSnapshotBridge(source)
+
+	// the momentum 3 confirms the receive block
+	checkpoint, err := protocol.ComputeSnapshotCheckpoint(sourceBridge, 3)
+	common.FailIfErr(t, err)
+	parsed, err := protocol.ParseSnapshotCheckpoint(checkpoint.String())
+	common.FailIfErr(t, err)
+	common.ExpectString(t, parsed.String(), checkpoint.String())
+
+	// too recent to be served
+	common.ExpectUint64(t, sourceBridge.GetSnapshotManifest(checkpoint.Identifier()).Height, 0)
+
+	state, consensusEntries := downloadSnapshot(t, checkpoint, sourceBridge)
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	bridge := newSnapshotBridge(z)
+
+	common.FailIfErr(t, bridge.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries))
+	common.ExpectString(t, bridge.CurrentBlock().Hash.String(), checkpoint.Hash.String())
+	z.ExpectBalance(g.User2.Address, types.ZnnTokenStandard, 8010*g.Zexp)
+	z.ExpectBalance(g.User1.Address, types.ZnnTokenStandard, 11990*g.Zexp)
+	_, _, err = z.Chain().GetStateEntries(types.HashHeight{Height: 2, Hash: checkpoint.Hash}, nil, 0)
+	common.ExpectError(t, err, db.ErrPruned)
+
+	// the state can be installed only once
+	common.ExpectTrue(t, bridge.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries) != nil)
+
+	momentums := make([]*nom.DetailedMomentum, 0)
+	for height := uint64(4); height <= 30; height += 1 {
+		momentum, err := sourceBridge.GetBlockByNumber(height)
+		common.FailIfErr(t, err)
+		momentums = append(momentums, sourceBridge.GetBlock(momentum.Hash))
+	}
+	_, err = bridge.InsertChain(momentums)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, bridge.CurrentBlock().Hash.String(), sourceBridge.CurrentBlock().Hash.String())
+
+	// the installed snapshot is served again, with the rollback of the inserted momentums
+	served, err := protocol.ComputeSnapshotCheckpoint(bridge, 3)
+	common.FailIfErr(t, err)
+	common.ExpectString(t, served.String(), checkpoint.String())
+}
+
+// - snapshots which don't match the checkpoint are rejected before they are installed
+// - snapshots with altered momentums or consensus entries aren't installed
+func TestSnapshot_Rejected(t *testing.T) {
+	source := newArchiveSource(t)
+	defer source.StopPanic()
+	sourceBridge := newSnapshotBridge(source)
+
+	checkpoint, err := protocol.ComputeSnapshotCheckpoint(sourceBridge, 3)
+	common.FailIfErr(t, err)
+
+	// the digest of another snapshot
+	otherCheckpoint, err := protocol.ComputeSnapshotCheckpoint(sourceBridge, 4)
+	common.FailIfErr(t, err)
+	wrongDigest := *checkpoint
+	wrongDigest.Digest = otherCheckpoint.Digest
+	common.ExpectError(t, protocol.DownloadSnapshot(&wrongDigest, snapshotFetcher(sourceBridge, checkpoint.Identifier()), db.NewMemDB(), db.NewMemDB()), protocol.ErrSnapshotDigest)
+
+	// a served entry is altered
+	fetch := snapshotFetcher(sourceBridge, checkpoint.Identifier())
+	altered := func(space protocol.SnapshotSpace, from []byte) (*protocol.SnapshotChunk, error) {
+		chunk, err := fetch(space, from)
+		if err != nil || space != protocol.SnapshotState || len(from) != 0 {
+			return chunk, err
+		}
+		entries, err := db.NewPatchFromDump(chunk.Entries)
+		common.FailIfErr(t, err)
+		alteredEntries := db.NewMemDB()
+		common.FailIfErr(t, alteredEntries.Apply(entries))
+		iterator := alteredEntries.NewIterator(nil)
+		iterator.Next()
+		common.FailIfErr(t, alteredEntries.Put(append([]byte{}, iterator.Key()...), []byte{1, 2, 3}))
+		iterator.Release()
+		changes, err := alteredEntries.Changes()
+		common.FailIfErr(t, err)
+		chunk.Entries = changes.Dump()
+		return chunk, nil
+	}
+	common.ExpectError(t, protocol.DownloadSnapshot(checkpoint, altered, db.NewMemDB(), db.NewMemDB()), protocol.ErrSnapshotDigest)
+
+	z := mock.NewMockZenon(t)
+	defer z.StopPanic()
+	bridge := newSnapshotBridge(z)
+
+	// the state of another momentum
+	state, consensusEntries := downloadSnapshot(t, checkpoint, sourceBridge)
+	common.ExpectTrue(t, bridge.InsertSnapshot(otherCheckpoint.Identifier(), state, consensusEntries) != nil)
+
+	// a momentum of the lineage is missing
+	common.FailIfErr(t, state.Delete(db.EntryByHeightKey(2)))
+	common.ExpectTrue(t, bridge.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries) != nil)
+
+	// unexpected consensus entries
+	state, consensusEntries = downloadSnapshot(t, checkpoint, sourceBridge)
+	common.FailIfErr(t, consensusEntries.Put([]byte{99}, []byte{1}))
+	common.ExpectTrue(t, bridge.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries) != nil)
+
+	common.ExpectUint64(t, bridge.CurrentBlock().Height, 1)
+	state, consensusEntries = downloadSnapshot(t, checkpoint, sourceBridge)
+	common.FailIfErr(t, bridge.InsertSnapshot(checkpoint.Identifier(), state, consensusEntries))
+	common.ExpectString(t, bridge.CurrentBlock().Hash.String(), checkpoint.Hash.String())
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"testing"
 
+	"github.com/zenon-network/go-zenon/chain/genesis"
 	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
@@ -163,3 +164,26 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 	types.ImplementedSporksMap[types.HexToHashPanic("eedcf4003fedfa69a0494e8b09c156f70c3e790af563642d0222514c3078966f")] = true
 	z.InsertMomentumsTo(20)
 }
+
+// newEmbeddedContractsGenesis returns the mock genesis with the EmbeddedContracts section enabling the contracts
+// and activating the sporks since the genesis momentum
+func newEmbeddedContractsGenesis(t *testing.T, enabled []types.Address, sporks ...types.Hash) *genesis.GenesisConfig {
+	config := *g.EmbeddedGenesis
+	config.EmbeddedContracts = &genesis.EmbeddedContractsConfig{
+		Enabled:      enabled,
+		ActiveSporks: sporks,
+	}
+	common.FailIfErr(t, genesis.CheckGenesis(&config))
+	return &config
+}
+
+// Test sporks activated by the genesis
+func TestSpork_ActiveSinceGenesis(t *testing.T) {
+	z := mock.NewMockZenonWithCustomGenesis(t, newEmbeddedContractsGenesis(t, types.EmbeddedContracts, types.HtlcSpork.SporkId))
+	defer z.StopPanic()
+
+	common.Json(z.Chain().GetFrontierMomentumStore().IsSporkActive(types.HtlcSpork)).Equals(t, `true`)
+	common.Json(z.Chain().GetFrontierMomentumStore().IsSporkActive(types.BridgeAndLiquiditySpork)).Equals(t, `false`)
+	z.InsertMomentumsTo(5)
+	common.Json(z.Chain().GetFrontierMomentumStore().IsSporkActive(types.HtlcSpork)).Equals(t, `true`)
+}
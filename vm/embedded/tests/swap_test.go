@@ -159,12 +159,12 @@ func TestSwapVerify_RetrieveAssets(t *testing.T) {
 		Data:      methodData,
 		Amount:    common.Big0,
 	}
-	err = method.ValidateSendBlock(block)
+	err = method.ValidateSendBlock(constants.DefaultChainParams(), block)
 	common.FailIfErr(t, err)
 	common.ExpectBytes(t, block.Data, encodedMethod)
 
 	// test second pair of legacy keys
-	common.FailIfErr(t, method.ValidateSendBlock(&nom.AccountBlock{
+	common.FailIfErr(t, method.ValidateSendBlock(constants.DefaultChainParams(), &nom.AccountBlock{
 		Address:   g.User1.Address,
 		ToAddress: types.PillarContract,
 		Data: definition.ABISwap.PackMethodPanic(
@@ -183,3 +183,19 @@ func TestSwap_GetKeyIdHash(t *testing.T) {
 	keyIdHashHex := hex.EncodeToString(keyIdHash.Bytes())
 	common.ExpectString(t, keyIdHashHex, g.Secp1KeyIdHex)
 }
+
+// Test send blocks to a contract which is not enabled by the genesis
+func TestSwap_DisabledContract(t *testing.T) {
+	z := mock.NewMockZenonWithCustomGenesis(t, newEmbeddedContractsGenesis(t, []types.Address{types.PlasmaContract, types.PillarContract, types.TokenContract}))
+	defer z.StopPanic()
+
+	z.InsertSendBlock(&nom.AccountBlock{
+		Address:   g.User1.Address,
+		ToAddress: types.SwapContract,
+		Data: definition.ABISwap.PackMethodPanic(
+			definition.RetrieveAssetsMethodName,
+			g.Secp1PubKeyB64,
+			stringErrDealWith(implementation.SignRetrieveAssetsMessage(g.User1.Address, g.Secp1PrvKey, g.Secp1PubKeyB64))),
+	}, constants.ErrContractDisabled, mock.NoVmChanges)
+	z.InsertNewMomentum()
+}
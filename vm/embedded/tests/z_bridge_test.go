@@ -49,6 +49,13 @@ func activateBridge(z mock.MockZenon) {
 	types.ImplementedSporksMap[id] = true
 }
 
+// bridgeTestChainParams shortens the bridge delays for the tests
+func bridgeTestChainParams(params *constants.ChainParams) {
+	params.MinAdministratorDelay = 20
+	params.MinSoftDelay = 10
+	params.MinUnhaltDurationInMomentums = 5
+}
+
 // Activate spork
 func activateBridgeStep0(t *testing.T, z mock.MockZenon) {
 	activateBridge(z)
@@ -56,9 +63,6 @@ func activateBridgeStep0(t *testing.T, z mock.MockZenon) {
 
 	bridgeAPI := embedded.NewBridgeApi(z)
 	constants.InitialBridgeAdministrator.SetBytes(g.User5.Address.Bytes())
-	constants.MinAdministratorDelay = 20
-	constants.MinSoftDelay = 10
-	constants.MinUnhaltDurationInMomentums = 5
 
 	common.Json(bridgeAPI.GetBridgeInfo()).Equals(t, `
 {
@@ -731,7 +735,7 @@ func activateBridgeStep9(t *testing.T, z mock.MockZenon) {
 }
 
 func TestBridge(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -763,7 +767,7 @@ t=2001-09-09T02:05:10+0000 lvl=dbug msg="minted ZTS" module=embedded contract=to
 }
 
 func TestBridge_ActionsWhenInEmergency(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -931,7 +935,7 @@ t=2001-09-09T02:01:50+0000 lvl=dbug msg="burned ZTS" module=embedded contract=to
 }
 
 func TestBridge_ActionsWhenHalted(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -1345,7 +1349,7 @@ t=2001-09-09T02:01:50+0000 lvl=dbug msg="burned ZTS" module=embedded contract=to
 }
 
 func TestBridge_WrapToken(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -1551,7 +1555,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_UpdateWrapToken(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -1728,7 +1732,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_UnwrapToken(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -1981,7 +1985,7 @@ t=2001-09-09T02:05:00+0000 lvl=eror msg=Unwrap-ErrInvalidSignature module=embedd
 }
 
 func TestBridge_Redeem(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2089,7 +2093,7 @@ t=2001-09-09T02:05:10+0000 lvl=dbug msg="minted ZTS" module=embedded contract=to
 }
 
 func TestBridge_SetNetwork(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2277,7 +2281,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_SetTokenPair(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2550,7 +2554,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_Halt(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2609,7 +2613,7 @@ t=2001-09-09T01:59:40+0000 lvl=eror msg=Halt-ErrInvalidSignature module=embedded
 }
 
 func TestBridge_Emergency(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2652,7 +2656,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_ChangeAdministrator(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2787,7 +2791,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_ChangeTss(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2925,7 +2929,7 @@ t=2001-09-09T02:00:10+0000 lvl=eror msg=ChangeTssECDSAPubKey-ErrInvalidNewKeySig
 }
 
 func TestBridge_SetOrchestratorInfo(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2959,7 +2963,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_SetBridgeMetadata(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -2998,7 +3002,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_NominateGuardians(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -3068,7 +3072,7 @@ t=2001-09-09T01:47:00+0000 lvl=dbug msg=activated module=embedded contract=spork
 }
 
 func TestBridge_ProposeAdministrator(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, bridgeTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:c6a597f757168bd5c9fddf52b16b3bf38e2ef781fb8edeea1bf2ae0d3225230d Name:spork-bridge Description:activate spork for bridge Activated:false EnforcementHeight:0}"
@@ -91,6 +91,14 @@ func issueMultipleTokensSetup(t *testing.T, z mock.MockZenon) {
 	autoreceive(t, z, g.User1.Address)
 }
 
+// liquidityTestChainParams shortens the bridge delays and the epochs for the tests
+func liquidityTestChainParams(params *constants.ChainParams) {
+	params.MinAdministratorDelay = 20
+	params.MinSoftDelay = 10
+	params.MomentumsPerEpoch = 10
+	params.MinUnhaltDurationInMomentums = 5
+}
+
 // activate accelerator spork
 // activate bridge spork
 func activateLiquidityStep0(t *testing.T, z mock.MockZenon) {
@@ -99,10 +107,6 @@ func activateLiquidityStep0(t *testing.T, z mock.MockZenon) {
 
 	constants.InitialBridgeAdministrator = g.User5.Address
 	constants.MinGuardians = 4
-	constants.MinAdministratorDelay = 20
-	constants.MinSoftDelay = 10
-	constants.MomentumsPerEpoch = 10
-	constants.MinUnhaltDurationInMomentums = 5
 
 	z.InsertMomentumsTo(500)
 	z.ExpectBalance(types.LiquidityContract, types.ZnnTokenStandard, 187200000000)
@@ -489,7 +493,7 @@ func activateLiquidityStep8(t *testing.T, z mock.MockZenon) {
 }
 
 func TestLiquidity(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 
 	activateLiquidityStep8(t, z)
@@ -607,7 +611,7 @@ func TestLiquidity_Fund(t *testing.T) {
 }
 
 func TestLiquidity_SetTokenTuples(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	//defer z.SaveLogs(common.EmbeddedLogger).Equals(t, ``)
 
@@ -856,7 +860,7 @@ func TestLiquidity_SetTokenTuples(t *testing.T) {
 }
 
 func TestLiquidity_StakeLiquidity(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	//defer z.SaveLogs(common.EmbeddedLogger).Equals(t, ``)
 
@@ -917,7 +921,7 @@ func TestLiquidity_StakeLiquidity(t *testing.T) {
 }
 
 func TestLiquidity_SetAdditionalRewards(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	//defer z.SaveLogs(common.EmbeddedLogger).Equals(t, ``)
 
@@ -963,7 +967,7 @@ func TestLiquidity_SetAdditionalRewards(t *testing.T) {
 }
 
 func TestLiquidity_CancelLiquidityStake(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -1068,7 +1072,7 @@ t=2001-09-09T03:51:30+0000 lvl=dbug msg="revoked liquidity stake entry" module=e
 }
 
 func TestLiquidity_UnlockLiquidityEntries(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -1315,7 +1319,7 @@ t=2001-09-09T03:52:10+0000 lvl=dbug msg="revoked liquidity stake entry" module=e
 }
 
 func TestLiquidity_TestScenariosNoAdditionalRewards(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	//defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, ``)
 
@@ -1707,7 +1711,7 @@ func TestLiquidity_TestScenariosNoAdditionalRewards(t *testing.T) {
 }
 
 func TestLiquidity_TestScenariosWithAdditionalRewards(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	//defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, ``)
 
@@ -2111,7 +2115,7 @@ func TestLiquidity_TestScenariosWithAdditionalRewards(t *testing.T) {
 // Rewards for second entry (token: LIQ2, amount: 10*10^8) -> 100% * 5616*10^7 znn, 100% * 3500*10^8 qsr
 // Total rewards -> (13104 + 5616) * 10^7 znn, (1500 + 3500) * 10^8 qsr
 func TestLiquidity_LiquidityStakeAndUpdate1(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -2246,7 +2250,7 @@ t=2001-09-09T03:46:50+0000 lvl=dbug msg="updating contract state" module=embedde
 // Minted ZTS (token: znn, amount: 5616*10^7)
 // Minted ZTS (token: qsr, amount: 3500*10^8)
 func TestLiquidity_LiquidityStakeAndUpdate2(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -2363,7 +2367,7 @@ t=2001-09-09T03:47:10+0000 lvl=info msg="received donation" module=embedded cont
 //
 //	38361408882 + 37442572741 + 37212863705 + 36983154670 + 350000000002 = 5000 * 10^8 qsr
 func TestLiquidity_LiquidityStakeAndUpdate3(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:34d8229bd07586c243c6e74122a18d6d2002694c72964a7186111026a9cec6ab Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -2658,7 +2662,7 @@ func TestLiquidity_Update(t *testing.T) {
 // Rewards for second entry (token: qsr, amount: 10*10^8) -> 100% * 946*10^8 znn, 100% * 2506*10^8 qsr
 // Halt and check that no additional rewards are added to the user and are minted to the contract instead
 func TestLiquidity_CollectReward1(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -2869,7 +2873,7 @@ t=2001-09-09T04:47:20+0000 lvl=info msg="received donation" module=embedded cont
 // Rewards for first entry (token: znn, amount: 10*10^8, User1) -> 100% * 961*10^8 znn, 100% * 2506*10^8 qsr
 // Rewards for second entry (token: qsr, amount: 10*10^8, User2) -> 100% * 961*10^8 znn, 100% * 2506*10^8 qsr
 func TestLiquidity_CollectReward2(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3103,7 +3107,7 @@ t=2001-09-09T03:48:40+0000 lvl=dbug msg="minted ZTS" module=embedded contract=to
 }
 
 func TestLiquidity_ChangeAdministrator(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).HideHashes().Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:XXXHASHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3177,7 +3181,7 @@ t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded cont
 }
 
 func TestLiquidity_NominateGuardians(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:34d8229bd07586c243c6e74122a18d6d2002694c72964a7186111026a9cec6ab Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3269,7 +3273,7 @@ t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded cont
 }
 
 func TestLiquidity_ProposeAdministrator(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:34d8229bd07586c243c6e74122a18d6d2002694c72964a7186111026a9cec6ab Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3423,7 +3427,7 @@ t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded cont
 }
 
 func TestLiquidity_Emergency(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:34d8229bd07586c243c6e74122a18d6d2002694c72964a7186111026a9cec6ab Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3498,7 +3502,7 @@ t=2001-09-09T02:47:00+0000 lvl=info msg="received donation" module=embedded cont
 }
 
 func TestLiquidity_SetIsHalted(t *testing.T) {
-	z := mock.NewMockZenonWithCustomEpochDuration(t, time.Hour)
+	z := mock.NewMockZenonWithChainParams(t, time.Hour, liquidityTestChainParams)
 	defer z.StopPanic()
 	defer z.SaveLogs(common.EmbeddedLogger).Equals(t, `
 t=2001-09-09T01:46:50+0000 lvl=dbug msg=created module=embedded contract=spork spork="&{Id:34d8229bd07586c243c6e74122a18d6d2002694c72964a7186111026a9cec6ab Name:spork-accelerator Description:activate spork for accelerator Activated:false EnforcementHeight:0}"
@@ -3687,7 +3691,7 @@ func setTokensTuple(t *testing.T, z mock.MockZenon, administrator types.Address,
 
 	frMom, err := z.Chain().GetFrontierMomentumStore().GetFrontierMomentum()
 	common.FailIfErr(t, err)
-	z.InsertMomentumsTo(frMom.Height + uint64(constants.MomentumsPerEpoch))
+	z.InsertMomentumsTo(frMom.Height + uint64(z.Chain().GetChainParams().MomentumsPerEpoch))
 
 	defer z.CallContract(setTokensTupleStep(administrator, customZts, znnPercentages, qsrPercentages, minAmounts)).Error(t, nil)
 	insertMomentums(z, 2)
@@ -36,18 +36,18 @@ func DifficultyToPlasma(difficulty uint64) uint64 {
 	return difficulty / constants.PoWDifficultyPerPlasma
 }
 
-func FussedAmountToPlasma(amount *big.Int) uint64 {
+func FussedAmountToPlasma(params *constants.ChainParams, amount *big.Int) uint64 {
 	// Check for 0
 	if amount == nil || amount.Sign() <= 0 {
 		return 0
 	}
 	// Check for more than max plasma allowed
-	if amount.Cmp(constants.MaxFussedAmountForAccountBig) >= 0 {
+	numUnits := new(big.Int).Quo(amount, params.CostPerFusionUnit)
+	if numUnits.Cmp(big.NewInt(constants.MaxFusionUnitsPerAccount)) >= 0 {
 		return constants.MaxFusionPlasmaForAccount
 	}
 
-	numUnits := amount.Uint64() / constants.CostPerFusionUnit
-	return numUnits * constants.PlasmaPerFusionUnit
+	return numUnits.Uint64() * constants.PlasmaPerFusionUnit
 }
 
 // AvailablePlasma returns only the total amount of plasma available.
@@ -68,7 +68,7 @@ func AvailablePlasma(momentum store.Momentum, account store.Account) (uint64, er
 	if err != nil {
 		return 0, err
 	}
-	fusedPlasma := big.NewInt(int64(FussedAmountToPlasma(fused)))
+	fusedPlasma := big.NewInt(int64(FussedAmountToPlasma(momentum.GetChainParams(), fused)))
 
 	answer := new(big.Int).Add(fusedPlasma, committed)
 	answer = answer.Sub(answer, uncommitted)
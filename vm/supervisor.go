@@ -45,8 +45,10 @@ func NewSupervisor(chain chain.Chain, consensus consensus.Consensus) *Supervisor
 }
 
 func (s *Supervisor) newBlockContext(block *nom.AccountBlock) vm_context.AccountVmContext {
-	momentumStore := s.chain.GetMomentumStore(block.MomentumAcknowledged)
-	accountStore := s.chain.GetAccountStore(block.Address, block.Previous())
+	momentumStore, err := s.chain.GetMomentumStore(block.MomentumAcknowledged)
+	common.DealWithErr(err)
+	accountStore, err := s.chain.GetAccountStore(block.Address, block.Previous())
+	common.DealWithErr(err)
 	cache := s.consensus.FixedPillarReader(block.MomentumAcknowledged)
 	if momentumStore == nil {
 		panic(fmt.Sprintf("can't find momentumStore for %v", block.MomentumAcknowledged))
@@ -64,9 +66,9 @@ func (s *Supervisor) newBlockContext(block *nom.AccountBlock) vm_context.Account
 	)
 }
 func (s *Supervisor) newMomentumContext(momentum *nom.Momentum) vm_context.MomentumVMContext {
-	return vm_context.NewMomentumVMContext(
-		s.chain.GetMomentumStore(momentum.Previous()),
-	)
+	momentumStore, err := s.chain.GetMomentumStore(momentum.Previous())
+	common.DealWithErr(err)
+	return vm_context.NewMomentumVMContext(momentumStore)
 }
 
 func (s *Supervisor) ApplyBlock(block *nom.AccountBlock) (*nom.AccountBlockTransaction, error) {
@@ -174,7 +176,7 @@ func (s *Supervisor) GenerateMomentum(detailed *nom.DetailedMomentum, signFunc S
 	}
 	return transaction, nil
 }
-func (s *Supervisor) GenerateGenesisMomentum(template *nom.Momentum, pool chain.AccountPool) (result *nom.MomentumTransaction, internalErr error) {
+func (s *Supervisor) GenerateGenesisMomentum(template *nom.Momentum, pool chain.AccountPool, chainParams *constants.ChainParams) (result *nom.MomentumTransaction, internalErr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			s.log.Error("vm panic when applying momentum", "identifier", template.Identifier(), "reason", err, "stack", string(debug.Stack()))
@@ -184,7 +186,7 @@ func (s *Supervisor) GenerateGenesisMomentum(template *nom.Momentum, pool chain.
 		}
 	}()
 
-	context := vm_context.NewGenesisMomentumVMContext()
+	context := vm_context.NewGenesisMomentumVMContext(chainParams)
 	vm := NewMomentumVM(context)
 	err := vm.applyMomentum(pool, template)
 	if err != nil {
@@ -123,7 +123,7 @@ func (vm *VM) applySend(block *nom.AccountBlock) error {
 		}
 
 		// validate block
-		err = method.ValidateSendBlock(block)
+		err = method.ValidateSendBlock(vm.context.ChainParams(), block)
 		if err != nil {
 			return err
 		}
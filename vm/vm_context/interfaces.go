@@ -7,6 +7,7 @@ import (
 	"github.com/zenon-network/go-zenon/chain/store"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus/api"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 type AccountVmContext interface {
@@ -18,6 +19,7 @@ type AccountVmContext interface {
 
 	GetFrontierMomentum() (*nom.Momentum, error)
 	GetGenesisMomentum() *nom.Momentum
+	ChainParams() *constants.ChainParams
 
 	// ====== Lifecycle ======
 
@@ -36,4 +38,5 @@ type AccountVmContext interface {
 	IsHtlcSporkEnforced() bool
 	IsBridgeAndLiquiditySporkEnforced() bool
 	IsNoPillarRegSporkEnforced() bool
+	IsConsensusParametersSporkEnforced() bool
 }
@@ -3,6 +3,7 @@ package vm_context
 import (
 	"github.com/zenon-network/go-zenon/chain/momentum"
 	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 type MomentumVMContext interface {
@@ -19,8 +20,8 @@ func NewMomentumVMContext(store store.Momentum) MomentumVMContext {
 	}
 }
 
-func NewGenesisMomentumVMContext() MomentumVMContext {
+func NewGenesisMomentumVMContext(chainParams *constants.ChainParams) MomentumVMContext {
 	return &momentumVMContext{
-		Momentum: momentum.NewGenesisStore(),
+		Momentum: momentum.NewGenesisStore(chainParams),
 	}
 }
@@ -28,3 +28,9 @@ func (ctx *accountVmContext) IsNoPillarRegSporkEnforced() bool {
 	common.DealWithErr(err)
 	return active
 }
+
+func (ctx *accountVmContext) IsConsensusParametersSporkEnforced() bool {
+	active, err := ctx.momentumStore.IsSporkActive(types.ConsensusParametersSpork)
+	common.DealWithErr(err)
+	return active
+}
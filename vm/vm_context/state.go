@@ -2,6 +2,7 @@ package vm_context
 
 import (
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
 func (ctx *accountVmContext) GetFrontierMomentum() (*nom.Momentum, error) {
@@ -10,3 +11,6 @@ func (ctx *accountVmContext) GetFrontierMomentum() (*nom.Momentum, error) {
 func (ctx *accountVmContext) GetGenesisMomentum() *nom.Momentum {
 	return ctx.momentumStore.GetGenesisMomentum()
 }
+func (ctx *accountVmContext) ChainParams() *constants.ChainParams {
+	return ctx.momentumStore.GetChainParams()
+}
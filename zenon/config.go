@@ -6,7 +6,9 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 
 	"github.com/zenon-network/go-zenon/chain/store"
+	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/db"
+	"github.com/zenon-network/go-zenon/protocol"
 	"github.com/zenon-network/go-zenon/wallet"
 )
 
@@ -16,10 +18,28 @@ type Config struct {
 	DataDir           string
 	ProducingKeyPair  *wallet.KeyPair
 	GenesisConfig     store.Genesis
+	// Pruning is the number of momentums kept by the pruning mode, 0 keeps the whole history
+	Pruning uint64
+	// SnapshotCheckpoint enables the snapshot sync of the protocol, nil disables it
+	SnapshotCheckpoint *protocol.SnapshotCheckpoint
+	// DBBackend is the storage of the chain DB, see db.NewManager
+	DBBackend string
+	// Indexer enables the account-block search indexes and the balance history, built in the background
+	Indexer bool
 }
 
+// DBPath returns the location of the chain DB inside with the configured backend.
+// Each backend has its own directory, so that a migration keeps the original DB.
+func (c *Config) DBPath(inside string) string {
+	if c.DBBackend == db.PebbleBackend {
+		return path.Join(c.DataDir, inside+"-"+db.PebbleBackend)
+	}
+	return path.Join(c.DataDir, inside)
+}
 func (c *Config) NewDBManager(inside string) db.Manager {
-	return db.NewLevelDBManager(path.Join(c.DataDir, inside))
+	manager, err := db.NewManager(c.DBBackend, c.DBPath(inside))
+	common.DealWithErr(err)
+	return manager
 }
 func (c *Config) NewLevelDB(inside string) (db.DB, *leveldb.DB) {
 	return db.NewLevelDB(path.Join(c.DataDir, inside))
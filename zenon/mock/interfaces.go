@@ -12,12 +12,17 @@ type MockZenon interface {
 	StopPanic()
 
 	InsertNewMomentum()
+	// InsertMomentumAfterMissedSlots leaves the next missed slots without a momentum
+	InsertMomentumAfterMissedSlots(missed uint64)
 	InsertMomentumsTo(targetHeight uint64)
 
 	CallContract(template *nom.AccountBlock) *common.Expecter
 	InsertSendBlock(template *nom.AccountBlock, expectedError error, expectedVmChanges string) *nom.AccountBlock
 	InsertReceiveBlock(fromHeader types.AccountHeader, template *nom.AccountBlock, expectedError error, expectedVmChanges string) *nom.AccountBlock
 
+	// WaitIndexer waits for the indexer to reach the frontier momentum
+	WaitIndexer()
+
 	SaveLogs(logger common.Logger) *common.Expecter
 	ExpectBalance(address types.Address, standard types.ZenonTokenStandard, expected int64)
 }
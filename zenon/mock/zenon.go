@@ -18,10 +18,12 @@ import (
 	"github.com/zenon-network/go-zenon/common/db"
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/indexer"
 	"github.com/zenon-network/go-zenon/pillar"
 	"github.com/zenon-network/go-zenon/protocol"
 	"github.com/zenon-network/go-zenon/verifier"
 	"github.com/zenon-network/go-zenon/vm"
+	"github.com/zenon-network/go-zenon/vm/constants"
 	"github.com/zenon-network/go-zenon/vm/vm_context"
 	"github.com/zenon-network/go-zenon/zenon"
 )
@@ -126,6 +128,7 @@ type mockZenon struct {
 	chain      chain.Chain
 	consensus  consensus.Consensus
 	supervisor *vm.Supervisor
+	indexer    indexer.Indexer
 
 	loggers              []log15.Logger
 	handlers             []log15.Handler
@@ -168,10 +171,13 @@ func (zenon *mockZenon) CreateAccountBlock(accountBlockTransaction *nom.AccountB
 }
 
 func (zenon *mockZenon) InsertNewMomentum() {
+	zenon.InsertMomentumAfterMissedSlots(0)
+}
+func (zenon *mockZenon) InsertMomentumAfterMissedSlots(missed uint64) {
 	store := zenon.chain.GetFrontierMomentumStore()
 	previousMomentum, err := store.GetFrontierMomentum()
 	common.DealWithErr(err)
-	t := previousMomentum.Timestamp.Add(time.Second * 10)
+	t, blockTime := zenon.nextSlot(*previousMomentum.Timestamp, missed)
 	expected, err := zenon.consensus.GetMomentumProducer(t)
 	common.DealWithErr(err)
 	if expected == nil {
@@ -183,12 +189,33 @@ func (zenon *mockZenon) InsertNewMomentum() {
 			pillarE.Process(consensus.ProducerEvent{
 				Producer:  *expected,
 				StartTime: t,
-				EndTime:   t.Add(time.Second * 10),
+				EndTime:   t.Add(blockTime),
 				Name:      "",
 			}).Wait()
 		}
 	}
 }
+
+// nextSlot returns the start time and the block time of the slot after the missed ones,
+// following the block time changes of the consensus sporks
+func (zenon *mockZenon) nextSlot(previous time.Time, missed uint64) (time.Time, time.Duration) {
+	parameters := zenon.consensus.ElectionScheduleReader().ConsensusParameters()
+	blockTime := func(t time.Time) time.Duration {
+		current := parameters[0]
+		for _, p := range parameters {
+			if p.StartTime <= t.Unix() {
+				current = p
+			}
+		}
+		return time.Second * time.Duration(current.BlockTime)
+	}
+
+	t := previous
+	for i := uint64(0); i <= missed; i += 1 {
+		t = t.Add(blockTime(t))
+	}
+	return t, blockTime(t)
+}
 func (zenon *mockZenon) InsertMomentumsTo(targetHeight uint64) {
 	currentHeight := zenon.chain.GetFrontierMomentumStore().Identifier().Height
 	for i := currentHeight + 1; i <= targetHeight; i += 1 {
@@ -277,6 +304,16 @@ func (zenon *mockZenon) EmbeddedContext(address types.Address) vm_context.Accoun
 
 }
 
+func (zenon *mockZenon) WaitIndexer() {
+	frontier := zenon.chain.GetFrontierMomentumStore().Identifier()
+	for i := 0; zenon.indexer.Frontier() != frontier; i += 1 {
+		if i == 1000 {
+			zenon.t.Fatalf("indexer didn't reach momentum %v, stuck at %v", frontier, zenon.indexer.Frontier())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func (zenon *mockZenon) SaveLogs(logger common.Logger) *common.Expecter {
 	return common.SaveLogs(logger)
 }
@@ -297,6 +334,9 @@ func (zenon *mockZenon) Init() error {
 	for _, pillarE := range zenon.pillars {
 		common.DealWithErr(pillarE.Init())
 	}
+	if zenon.indexer != nil {
+		common.DealWithErr(zenon.indexer.Init())
+	}
 	return nil
 }
 func (zenon *mockZenon) Start() error {
@@ -305,9 +345,15 @@ func (zenon *mockZenon) Start() error {
 	for _, pillarE := range zenon.pillars {
 		common.DealWithErr(pillarE.Start())
 	}
+	if zenon.indexer != nil {
+		common.DealWithErr(zenon.indexer.Start())
+	}
 	return nil
 }
 func (zenon *mockZenon) Stop() error {
+	if zenon.indexer != nil {
+		common.DealWithErr(zenon.indexer.Stop())
+	}
 	for _, pillarE := range zenon.pillars {
 		common.DealWithErr(pillarE.Stop())
 	}
@@ -317,6 +363,7 @@ func (zenon *mockZenon) Stop() error {
 	zenon.chain = nil
 	zenon.consensus = nil
 	zenon.pillars = nil
+	zenon.indexer = nil
 
 	for i := range zenon.loggers {
 		zenon.loggers[i].SetHandler(zenon.handlers[i])
@@ -351,22 +398,58 @@ func (zenon *mockZenon) Config() *zenon.Config {
 func (zenon *mockZenon) Broadcaster() protocol.Broadcaster {
 	return zenon
 }
+func (zenon *mockZenon) Indexer() indexer.Indexer {
+	return zenon.indexer
+}
 
 func NewMockZenon(t common.T) MockZenon {
-	return newMockZenon(t, consensus.EpochDuration)
+	return newMockZenon(t, consensus.EpochDuration, g.EmbeddedGenesis, 0, db.LevelDBBackend, false, nil)
 }
 func NewMockZenonWithCustomEpochDuration(t common.T, epochDuration time.Duration) MockZenon {
-	return newMockZenon(t, epochDuration)
+	return newMockZenon(t, epochDuration, g.EmbeddedGenesis, 0, db.LevelDBBackend, false, nil)
 }
 
-func newMockZenon(t common.T, customEpochDuration time.Duration) MockZenon {
+// NewMockZenonWithCustomGenesis starts a mock chain from config, the momentums are produced by the keys of g.PillarKeys
+func NewMockZenonWithCustomGenesis(t common.T, config *genesis.GenesisConfig) MockZenon {
+	return newMockZenon(t, consensus.EpochDuration, config, 0, db.LevelDBBackend, false, nil)
+}
+
+// NewMockZenonWithPruning starts a mock chain which keeps only the last pruning momentums
+func NewMockZenonWithPruning(t common.T, pruning uint64) MockZenon {
+	return newMockZenon(t, consensus.EpochDuration, g.EmbeddedGenesis, pruning, db.LevelDBBackend, false, nil)
+}
+
+// NewMockZenonWithDBBackend starts a mock chain stored with backend
+func NewMockZenonWithDBBackend(t common.T, backend string) MockZenon {
+	return newMockZenon(t, consensus.EpochDuration, g.EmbeddedGenesis, 0, backend, false, nil)
+}
+
+// NewMockZenonWithIndexer starts a mock chain which builds the account-block indexes, see WaitIndexer
+func NewMockZenonWithIndexer(t common.T) MockZenon {
+	return newMockZenon(t, consensus.EpochDuration, g.EmbeddedGenesis, 0, db.LevelDBBackend, true, nil)
+}
+
+// NewMockZenonWithChainParams starts a mock chain from the embedded genesis with the chain params changed by update,
+// which is called before the chain starts
+func NewMockZenonWithChainParams(t common.T, epochDuration time.Duration, update func(params *constants.ChainParams)) MockZenon {
+	return newMockZenon(t, epochDuration, g.EmbeddedGenesis, 0, db.LevelDBBackend, false, update)
+}
+
+func newMockZenon(t common.T, customEpochDuration time.Duration, config *genesis.GenesisConfig, pruning uint64, backend string, indexed bool, update func(params *constants.ChainParams)) MockZenon {
 	// silence loggers
 	common.ChainLogger.SetHandler(log15.LvlFilterHandler(log15.LvlError, log15.StderrHandler))
 	common.ConsensusLogger.SetHandler(log15.LvlFilterHandler(log15.LvlError, log15.StderrHandler))
 	common.SupervisorLogger.SetHandler(log15.LvlFilterHandler(log15.LvlError, log15.StderrHandler))
+	common.IndexerLogger.SetHandler(log15.LvlFilterHandler(log15.LvlError, log15.StderrHandler))
 	consensus.EpochDuration = customEpochDuration
 
-	ch := chain.NewChain(db.NewLevelDBManager(t.TempDir()), genesis.NewGenesis(g.EmbeddedGenesis))
+	manager, err := db.NewManager(backend, t.TempDir())
+	common.DealWithErr(err)
+	gen := genesis.NewGenesis(config)
+	if update != nil {
+		update(gen.GetChainParams())
+	}
+	ch := chain.NewPrunedChain(manager, gen, pruning)
 	cs := consensus.NewConsensus(db.NewMemDB(), ch, true)
 	supervisor := vm.NewSupervisor(ch, cs)
 	zenon := &mockZenon{
@@ -411,6 +494,11 @@ func newMockZenon(t common.T, customEpochDuration time.Duration) MockZenon {
 	}
 	zenon.pillars = pillars
 
+	if indexed {
+		_, indexDb := db.NewLevelDB(t.TempDir())
+		zenon.indexer = indexer.NewIndexer(ch, indexDb)
+	}
+
 	zenon.Init()
 	zenon.Start()
 
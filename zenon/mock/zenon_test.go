@@ -7,6 +7,7 @@ import (
 	g "github.com/zenon-network/go-zenon/chain/genesis/mock"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/consensus"
 	"github.com/zenon-network/go-zenon/vm/constants"
 )
 
@@ -26,7 +27,9 @@ func TestStateGenesis(t *testing.T) {
 
 func TestStateProducer(t *testing.T) {
 	time.Local = time.UTC
-	z := NewMockZenon(t)
+	z := NewMockZenonWithChainParams(t, consensus.EpochDuration, func(params *constants.ChainParams) {
+		params.UpdateMinNumMomentums = 5
+	})
 	defer z.StopPanic()
 
 	defer z.SaveLogs(common.PillarLogger).HideHashes().Equals(t, `
@@ -92,7 +95,5 @@ t=2001-09-09T01:48:10+0000 lvl=info msg="producing block to update embedded-cont
 t=2001-09-09T01:48:10+0000 lvl=eror msg="failed to update contracts" module=pillar submodule=worker reason="method not found in the abi"
 `)
 
-	constants.UpdateMinNumMomentums = 5
-
 	z.InsertMomentumsTo(10)
 }
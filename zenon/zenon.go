@@ -5,6 +5,7 @@ import (
 
 	"github.com/zenon-network/go-zenon/chain"
 	"github.com/zenon-network/go-zenon/consensus"
+	"github.com/zenon-network/go-zenon/indexer"
 	"github.com/zenon-network/go-zenon/pillar"
 	"github.com/zenon-network/go-zenon/protocol"
 	"github.com/zenon-network/go-zenon/rpc/api/subscribe"
@@ -23,6 +24,7 @@ type zenon struct {
 	consensus   consensus.Consensus
 	evPrinter   EventPrinter
 	broadcaster protocol.Broadcaster
+	indexer     indexer.Indexer
 	levelDb     *leveldb.DB
 }
 
@@ -31,19 +33,30 @@ func NewZenon(cfg *Config) (Zenon, error) {
 		config: cfg,
 	}
 
-	z.chain = chain.NewChain(cfg.NewDBManager("nom"), cfg.GenesisConfig)
+	z.chain = chain.NewPrunedChain(cfg.NewDBManager("nom"), cfg.GenesisConfig, cfg.Pruning)
 	db, levelDb := cfg.NewLevelDB("consensus")
 	z.consensus = consensus.NewConsensus(db, z.chain, false)
 	z.verifier = verifier.NewVerifier(z.chain, z.consensus)
 	z.levelDb = levelDb
 
 	chainBridge := protocol.NewChainBridge(z.chain, z.consensus, z.verifier, vm.NewSupervisor(z.chain, z.consensus))
-	z.protocol = protocol.NewProtocolManager(cfg.MinPeers, z.chain.ChainIdentifier(), chainBridge)
+	var snapshotConfig *protocol.SnapshotConfig
+	if cfg.SnapshotCheckpoint != nil {
+		snapshotConfig = &protocol.SnapshotConfig{
+			Checkpoint: cfg.SnapshotCheckpoint,
+			DataDir:    cfg.DataDir,
+		}
+	}
+	z.protocol = protocol.NewProtocolManager(cfg.MinPeers, z.chain.ChainIdentifier(), chainBridge, snapshotConfig)
 	z.broadcaster = protocol.NewBroadcaster(z.chain, z.protocol)
 
 	z.evPrinter = NewEventPrinter(z.chain, z.broadcaster)
-	z.subscribe = subscribe.GetSubscribeServer(z.chain)
+	z.subscribe = subscribe.GetSubscribeServer(z.chain, z.consensus)
 	z.pillar = pillar.NewPillar(z.chain, z.consensus, z.broadcaster)
+	if cfg.Indexer {
+		_, indexDb := cfg.NewLevelDB("index")
+		z.indexer = indexer.NewIndexer(z.chain, indexDb)
+	}
 
 	if cfg.ProducingKeyPair != nil {
 		z.pillar.SetCoinBase(cfg.ProducingKeyPair)
@@ -65,6 +78,11 @@ func (z *zenon) Init() error {
 	if err := z.subscribe.Init(); err != nil {
 		return err
 	}
+	if z.indexer != nil {
+		if err := z.indexer.Init(); err != nil {
+			return err
+		}
+	}
 	//z.protocol.Init()
 	if err := z.pillar.Init(); err != nil {
 		return err
@@ -85,6 +103,11 @@ func (z *zenon) Start() error {
 	if err := z.subscribe.Start(); err != nil {
 		return err
 	}
+	if z.indexer != nil {
+		if err := z.indexer.Start(); err != nil {
+			return err
+		}
+	}
 	if err := z.pillar.Start(); err != nil {
 		return err
 	}
@@ -97,6 +120,11 @@ func (z *zenon) Stop() error {
 	if err := z.pillar.Stop(); err != nil {
 		return err
 	}
+	if z.indexer != nil {
+		if err := z.indexer.Stop(); err != nil {
+			return err
+		}
+	}
 	if err := z.subscribe.Stop(); err != nil {
 		return err
 	}
@@ -137,3 +165,6 @@ func (z *zenon) Config() *Config {
 func (z *zenon) Broadcaster() protocol.Broadcaster {
 	return z.broadcaster
 }
+func (z *zenon) Indexer() indexer.Indexer {
+	return z.indexer
+}